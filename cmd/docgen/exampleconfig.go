@@ -33,8 +33,9 @@ func generateExampleConfig(
 
 func exampleConfig(applicationName string) config.Config {
 	return config.Config{
-		CredentialsFile: "/home/user/.local/config/" + applicationName + "/credentials/credentials.json",
-		CacheDirectory:  "/home/user/.local/cache/" + applicationName,
+		CredentialsFile:   "/home/user/.local/config/" + applicationName + "/credentials/credentials.json",
+		CacheDirectory:    "/home/user/.local/cache/" + applicationName,
+		MediaCacheMaxSize: 1024,
 		Aliases: map[string]string{
 			"aliases":      "show aliases",
 			"boost":        "reblog status --status-id",
@@ -44,12 +45,20 @@ func exampleConfig(applicationName string) config.Config {
 		},
 		LineWrapMaxWidth: 80,
 		GTSClient: config.GTSClient{
-			Timeout:      30,
-			MediaTimeout: 60,
+			Timeout:        30,
+			MediaTimeout:   60,
+			CacheResponses: true,
+			CacheTTL: config.CacheTTL{
+				Accounts: 60,
+				Instance: 3600,
+				Lists:    300,
+				Default:  0,
+			},
 		},
 		Server: config.Server{
-			SocketPath:  "/var/run/user/1000/" + applicationName + "/server.psqm2yeo.socket",
-			IdleTimeout: 300,
+			SocketPath:       "/var/run/user/1000/" + applicationName + "/server.psqm2yeo.socket",
+			IdleTimeout:      300,
+			NotifierInterval: 60,
 		},
 		Integrations: config.Integrations{
 			Browser:     "firefox --new-window",
@@ -58,6 +67,7 @@ func exampleConfig(applicationName string) config.Config {
 			ImageViewer: "feh --scale-down",
 			VideoPlayer: "mpv --loop-file=inf",
 			AudioPlayer: "mpv --force-window",
+			Notifier:    "/home/user/.local/bin/" + applicationName + "-notify",
 		},
 	}
 }
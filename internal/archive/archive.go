@@ -0,0 +1,98 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+// An archive is a self-contained directory with the following layout:
+//
+//	index.json           the index of the archived statuses
+//	statuses/<ID>.json   the JSON document of each archived status
+//	media/<file>         the media attachments of the archived statuses
+const (
+	indexFile   string = "index.json"
+	statusesDir string = "statuses"
+	mediaDir    string = "media"
+)
+
+// MediaDir returns the directory of the archive where the media attachments are saved.
+func MediaDir(dir string) string {
+	return filepath.Join(dir, mediaDir)
+}
+
+// SaveStatus saves the status to the archive and returns the path to the
+// saved file relative to the root of the archive.
+func SaveStatus(dir string, status model.Status) (string, error) {
+	if status.ID == "" || status.ID != filepath.Base(status.ID) || strings.HasPrefix(status.ID, ".") {
+		return "", InvalidStatusIDError{statusID: status.ID}
+	}
+
+	relativePath := filepath.Join(statusesDir, status.ID+".json")
+
+	if err := saveJSON(filepath.Join(dir, statusesDir), filepath.Join(dir, relativePath), status); err != nil {
+		return "", err
+	}
+
+	return relativePath, nil
+}
+
+// SaveIndex saves the index to the root of the archive.
+func SaveIndex(dir string, index model.ArchiveIndex) error {
+	return saveJSON(dir, filepath.Join(dir, indexFile), index)
+}
+
+// LoadIndex loads the index from the root of the archive.
+func LoadIndex(dir string) (model.ArchiveIndex, error) {
+	path := filepath.Join(dir, indexFile)
+
+	file, err := utilities.OpenFile(path)
+	if err != nil {
+		return model.ArchiveIndex{}, fmt.Errorf("unable to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var index model.ArchiveIndex
+
+	if err := json.NewDecoder(file).Decode(&index); err != nil {
+		return model.ArchiveIndex{}, fmt.Errorf("unable to decode the JSON data from %s: %w", path, err)
+	}
+
+	return index, nil
+}
+
+// RelativePath returns the path of the file relative to the root of the archive.
+func RelativePath(dir, path string) (string, error) {
+	relativePath, err := filepath.Rel(dir, path)
+	if err != nil {
+		return "", fmt.Errorf("unable to calculate the path of %s relative to %s: %w", path, dir, err)
+	}
+
+	return relativePath, nil
+}
+
+func saveJSON(dir, path string, data any) error {
+	if err := utilities.EnsureDirectory(dir); err != nil {
+		return fmt.Errorf("unable to ensure the existence of %s: %w", dir, err)
+	}
+
+	file, err := utilities.CreateFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to create the file at %s: %w", path, err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "    ")
+
+	if err := encoder.Encode(data); err != nil {
+		return fmt.Errorf("unable to save the JSON data to %s: %w", path, err)
+	}
+
+	return nil
+}
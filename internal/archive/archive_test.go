@@ -0,0 +1,85 @@
+package archive_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/archive"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+func TestSaveStatusAndIndex(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "archive")
+
+	status := model.Status{
+		ID:        "01J4VQ6XG5GCWZ3TZFS1KAKZ7E",
+		CreatedAt: time.Date(2026, time.October, 18, 8, 30, 0, 0, time.UTC),
+		Content:   "<p>Hello, world!</p>",
+		URL:       "https://gts.example/@enbas/statuses/01J4VQ6XG5GCWZ3TZFS1KAKZ7E",
+	}
+
+	statusFile, err := archive.SaveStatus(dir, status)
+	if err != nil {
+		t.Fatalf("Unable to save the status: %v", err)
+	}
+
+	if want := filepath.Join("statuses", status.ID+".json"); statusFile != want {
+		t.Errorf("Unexpected status file received: want %q, got %q", want, statusFile)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, statusFile)); err != nil {
+		t.Errorf("Unable to find the saved status: %v", err)
+	}
+
+	mediaFile, err := archive.RelativePath(dir, filepath.Join(archive.MediaDir(dir), "image.png"))
+	if err != nil {
+		t.Fatalf("Unable to calculate the relative path of the media file: %v", err)
+	}
+
+	index := model.ArchiveIndex{
+		Account:    model.Account{Acct: "enbas"},
+		ExportedAt: time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC),
+		Statuses: []model.ArchiveEntry{
+			{
+				ID:         status.ID,
+				CreatedAt:  status.CreatedAt,
+				URL:        status.URL,
+				Reblog:     false,
+				Reply:      false,
+				StatusFile: statusFile,
+				MediaFiles: []string{mediaFile},
+			},
+		},
+	}
+
+	if err := archive.SaveIndex(dir, index); err != nil {
+		t.Fatalf("Unable to save the index: %v", err)
+	}
+
+	loaded, err := archive.LoadIndex(dir)
+	if err != nil {
+		t.Fatalf("Unable to load the index: %v", err)
+	}
+
+	if len(loaded.Statuses) != 1 {
+		t.Fatalf("Unexpected number of statuses in the index: want 1, got %d", len(loaded.Statuses))
+	}
+
+	if want, got := filepath.Join("media", "image.png"), loaded.Statuses[0].MediaFiles[0]; want != got {
+		t.Errorf("Unexpected media file received: want %q, got %q", want, got)
+	}
+}
+
+func TestSaveStatusWithInvalidID(t *testing.T) {
+	for _, statusID := range []string{"", "../escape", ".hidden"} {
+		_, err := archive.SaveStatus(t.TempDir(), model.Status{ID: statusID})
+
+		var target archive.InvalidStatusIDError
+		if !errors.As(err, &target) {
+			t.Errorf("Unexpected error received for %q: got %v", statusID, err)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package archive
+
+type InvalidStatusIDError struct {
+	statusID string
+}
+
+func (e InvalidStatusIDError) Error() string {
+	return "'" + e.statusID + "' is not a valid status ID"
+}
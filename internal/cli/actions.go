@@ -12,16 +12,24 @@ const (
 	ActionClear       string = "clear"
 	ActionCreate      string = "create"
 	ActionDelete      string = "delete"
+	ActionDismiss     string = "dismiss"
 	ActionEdit        string = "edit"
+	ActionExport      string = "export"
 	ActionFavourite   string = "favourite"
 	ActionFind        string = "find"
 	ActionFollow      string = "follow"
+	ActionImport      string = "import"
 	ActionInvalidate  string = "invalidate"
 	ActionMute        string = "mute"
+	ActionPin         string = "pin"
+	ActionPrune       string = "prune"
+	ActionPublish     string = "publish"
+	ActionRead        string = "read"
 	ActionReblog      string = "reblog"
 	ActionReject      string = "reject"
 	ActionRemove      string = "remove"
 	ActionRename      string = "rename"
+	ActionReply       string = "reply"
 	ActionShow        string = "show"
 	ActionStart       string = "start"
 	ActionSwitch      string = "switch"
@@ -29,8 +37,10 @@ const (
 	ActionUnfavourite string = "unfavourite"
 	ActionUnfollow    string = "unfollow"
 	ActionUnmute      string = "unmute"
+	ActionUnpin       string = "unpin"
 	ActionUnreblog    string = "unreblog"
 	ActionVerify      string = "verify"
+	ActionWatch       string = "watch"
 )
 
 // IsAction returns true if the parameter is an existing action.
@@ -49,16 +59,24 @@ func actionSet() map[string]struct{} {
 		ActionClear:       {},
 		ActionCreate:      {},
 		ActionDelete:      {},
+		ActionDismiss:     {},
 		ActionEdit:        {},
+		ActionExport:      {},
 		ActionFavourite:   {},
 		ActionFind:        {},
 		ActionFollow:      {},
+		ActionImport:      {},
 		ActionInvalidate:  {},
 		ActionMute:        {},
+		ActionPin:         {},
+		ActionPrune:       {},
+		ActionPublish:     {},
+		ActionRead:        {},
 		ActionReblog:      {},
 		ActionReject:      {},
 		ActionRemove:      {},
 		ActionRename:      {},
+		ActionReply:       {},
 		ActionShow:        {},
 		ActionStart:       {},
 		ActionSwitch:      {},
@@ -66,7 +84,9 @@ func actionSet() map[string]struct{} {
 		ActionUnfavourite: {},
 		ActionUnfollow:    {},
 		ActionUnmute:      {},
+		ActionUnpin:       {},
 		ActionUnreblog:    {},
 		ActionVerify:      {},
+		ActionWatch:       {},
 	}
 }
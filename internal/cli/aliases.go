@@ -24,6 +24,10 @@ func builtInAliasMap() map[string][]string {
 			"create",
 			"access",
 		},
+		"tui": {
+			"start",
+			"tui",
+		},
 		"version": {
 			"show",
 			"version",
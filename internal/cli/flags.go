@@ -8,37 +8,80 @@ package cli
 const (
 	flagConfig  string = "config"
 	flagNoColor string = "no-color"
+	flagOutput  string = "output"
 )
 
 const (
 	flagAccountName               string = "account-name"
 	flagAddPoll                   string = "add-poll"
+	flagAll                       string = "all"
 	flagAllAudio                  string = "all-audio"
 	flagAllImages                 string = "all-images"
 	flagAllVideos                 string = "all-videos"
 	flagAttachmentId              string = "attachment-id"
+	flagAvatarDescription         string = "avatar-description"
+	flagAvatarFile                string = "avatar-file"
+	flagBio                       string = "bio"
+	flagBot                       string = "bot"
 	flagBrowser                   string = "browser"
+	flagCanFavouriteAlways        string = "can-favourite-always"
+	flagCanFavouriteWithApproval  string = "can-favourite-with-approval"
+	flagCanReblogAlways           string = "can-reblog-always"
+	flagCanReblogWithApproval     string = "can-reblog-with-approval"
+	flagCanReplyAlways            string = "can-reply-always"
+	flagCanReplyWithApproval      string = "can-reply-with-approval"
+	flagConfirm                   string = "confirm"
 	flagContent                   string = "content"
 	flagContentType               string = "content-type"
+	flagConversationId            string = "conversation-id"
+	flagDefaultContentType        string = "default-content-type"
+	flagDefaultLanguage           string = "default-language"
+	flagDefaultVisibility         string = "default-visibility"
+	flagDiscoverable              string = "discoverable"
+	flagDisplayName               string = "display-name"
+	flagDraftId                   string = "draft-id"
+	flagDryRun                    string = "dry-run"
 	flagDuration                  string = "duration"
+	flagEditBio                   string = "edit-bio"
 	flagExcludeNotificationType   string = "exclude-notification-type"
 	flagExcludeReblogs            string = "exclude-reblogs"
 	flagExcludeReplies            string = "exclude-replies"
 	flagExclusive                 string = "exclusive"
+	flagFile                      string = "file"
 	flagFilterAction              string = "filter-action"
 	flagFilterContext             string = "filter-context"
 	flagFilterExpiresIn           string = "filter-expires-in"
 	flagFilterId                  string = "filter-id"
 	flagFilterKeywordId           string = "filter-keyword-id"
 	flagFilterStatusId            string = "filter-status-id"
+	flagForLimitedAccounts        string = "for-limited-accounts"
+	flagForNewAccounts            string = "for-new-accounts"
+	flagForNotFollowers           string = "for-not-followers"
+	flagForNotFollowing           string = "for-not-following"
+	flagForPrivateMentions        string = "for-private-mentions"
+	flagFromStdin                 string = "from-stdin"
 	flagFull                      string = "full"
+	flagGroup                     string = "group"
+	flagHeaderDescription         string = "header-description"
+	flagHeaderFile                string = "header-file"
 	flagInReplyTo                 string = "in-reply-to"
 	flagIncludeNotificationType   string = "include-notification-type"
+	flagInteractionRequestId      string = "interaction-request-id"
+	flagInteractionType           string = "interaction-type"
+	flagKeepBookmarked            string = "keep-bookmarked"
+	flagKeepBoostsOver            string = "keep-boosts-over"
+	flagKeepLikesOver             string = "keep-likes-over"
+	flagKeepPinned                string = "keep-pinned"
+	flagKeepTag                   string = "keep-tag"
+	flagKeepVisibility            string = "keep-visibility"
 	flagKeyword                   string = "keyword"
 	flagLanguage                  string = "language"
 	flagLimit                     string = "limit"
 	flagListId                    string = "list-id"
 	flagLocalOnly                 string = "local-only"
+	flagLocked                    string = "locked"
+	flagMaxId                     string = "max-id"
+	flagMaxSize                   string = "max-size"
 	flagMaxStatuses               string = "max-statuses"
 	flagMediaDescription          string = "media-description"
 	flagMediaFile                 string = "media-file"
@@ -51,36 +94,47 @@ const (
 	flagNotLikeable               string = "not-likeable"
 	flagNotReplyable              string = "not-replyable"
 	flagNotificationId            string = "notification-id"
+	flagNotificationRequestId     string = "notification-request-id"
 	flagNotify                    string = "notify"
 	flagOldName                   string = "old-name"
+	flagOlderThan                 string = "older-than"
 	flagOnlyMedia                 string = "only-media"
 	flagOnlyPinned                string = "only-pinned"
 	flagOnlyPublic                string = "only-public"
 	flagOperation                 string = "operation"
+	flagOutputDir                 string = "output-dir"
 	flagPollAllowsMultipleChoices string = "poll-allows-multiple-choices"
 	flagPollExpiresIn             string = "poll-expires-in"
 	flagPollHidesVoteCounts       string = "poll-hides-vote-counts"
 	flagPollOption                string = "poll-option"
+	flagProfileField              string = "profile-field"
 	flagQuery                     string = "query"
 	flagRepliesPolicy             string = "replies-policy"
+	flagReset                     string = "reset"
 	flagResolve                   string = "resolve"
 	flagRestrictToFollowing       string = "restrict-to-following"
 	flagSaveText                  string = "save-text"
+	flagScheduledAt               string = "scheduled-at"
+	flagScheduledStatusId         string = "scheduled-status-id"
 	flagScope                     string = "scope"
 	flagSensitive                 string = "sensitive"
 	flagShowReblogs               string = "show-reblogs"
 	flagShowStatuses              string = "show-statuses"
 	flagShowWhoFavourited         string = "show-who-favourited"
 	flagShowWhoReblogged          string = "show-who-reblogged"
+	flagSinceId                   string = "since-id"
 	flagSkipAccountRelationship   string = "skip-account-relationship"
 	flagSkipUserPreferences       string = "skip-user-preferences"
 	flagStatusId                  string = "status-id"
+	flagStatusVisibility          string = "status-visibility"
 	flagSummary                   string = "summary"
 	flagTagName                   string = "tag-name"
 	flagTarget                    string = "target"
+	flagTheme                     string = "theme"
 	flagTimelineCategory          string = "timeline-category"
 	flagTitle                     string = "title"
 	flagTokenId                   string = "token-id"
+	flagUnread                    string = "unread"
 	flagUrl                       string = "url"
 	flagVisibility                string = "visibility"
 	flagVote                      string = "vote"
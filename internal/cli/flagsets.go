@@ -30,10 +30,21 @@ func newFlagset() *flag.FlagSet {
 func NewTopLevelFlagset(
 	config *string,
 	noColor *internalFlag.BoolValue,
+	output *internalFlag.EnumValue,
 ) *flag.FlagSet {
 	flagset := newFlagset()
 	flagset.StringVar(config, flagConfig, "", "")
 	flagset.Var(noColor, flagNoColor, "")
+	*output = internalFlag.NewEnumValue(
+		[]string{
+			"json",
+			"jsonl",
+			"text",
+		},
+		"text",
+	)
+
+	flagset.Var(output, flagOutput, "")
 
 	return flagset
 }
@@ -70,10 +81,78 @@ func ParseAccessSwitchToAccountFlags(
 
 func ParseAccountBlockFlags(
 	accountName *string,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseAccountEditFlags(
+	myAccount *bool,
+	displayName *string,
+	bio *string,
+	editBio *bool,
+	profileField *internalFlag.MultiStringValue,
+	avatarFile *string,
+	avatarDescription *string,
+	headerFile *string,
+	headerDescription *string,
+	locked *internalFlag.BoolValue,
+	bot *internalFlag.BoolValue,
+	discoverable *internalFlag.BoolValue,
+	defaultVisibility *internalFlag.EnumValue,
+	defaultLanguage *string,
+	defaultContentType *internalFlag.EnumValue,
+	theme *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.BoolVar(myAccount, flagMyAccount, false, "")
+	flagset.StringVar(displayName, flagDisplayName, "", "")
+	flagset.StringVar(bio, flagBio, "", "")
+	flagset.BoolVar(editBio, flagEditBio, false, "")
+	flagset.Var(profileField, flagProfileField, "")
+	flagset.StringVar(avatarFile, flagAvatarFile, "", "")
+	flagset.StringVar(avatarDescription, flagAvatarDescription, "", "")
+	flagset.StringVar(headerFile, flagHeaderFile, "", "")
+	flagset.StringVar(headerDescription, flagHeaderDescription, "", "")
+	*locked = internalFlag.NewBoolValue(false)
+	flagset.Var(locked, flagLocked, "")
+	*bot = internalFlag.NewBoolValue(false)
+	flagset.Var(bot, flagBot, "")
+	*discoverable = internalFlag.NewBoolValue(false)
+	flagset.Var(discoverable, flagDiscoverable, "")
+	*defaultVisibility = internalFlag.NewEnumValue(
+		[]string{
+			"public",
+			"unlisted",
+			"private",
+			"mutuals_only",
+			"direct",
+		},
+		"",
+	)
+
+	flagset.Var(defaultVisibility, flagDefaultVisibility, "")
+	flagset.StringVar(defaultLanguage, flagDefaultLanguage, "", "")
+	*defaultContentType = internalFlag.NewEnumValue(
+		[]string{
+			"plain",
+			"markdown",
+		},
+		"",
+	)
+
+	flagset.Var(defaultContentType, flagDefaultContentType, "")
+	flagset.StringVar(theme, flagTheme, "", "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -106,12 +185,14 @@ func ParseAccountFollowFlags(
 	accountName *string,
 	notify *bool,
 	showReblogs *bool,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
 	flagset.BoolVar(notify, flagNotify, false, "")
 	flagset.BoolVar(showReblogs, flagShowReblogs, true, "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -124,12 +205,14 @@ func ParseAccountMuteFlags(
 	accountName *string,
 	duration *internalFlag.TimeDurationValue,
 	muteNotifications *bool,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
 	flagset.Var(duration, flagDuration, "")
 	flagset.BoolVar(muteNotifications, flagMuteNotifications, false, "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -176,10 +259,12 @@ func ParseAccountShowFlags(
 
 func ParseAccountUnblockFlags(
 	accountName *string,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -190,10 +275,12 @@ func ParseAccountUnblockFlags(
 
 func ParseAccountUnfollowFlags(
 	accountName *string,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -204,10 +291,12 @@ func ParseAccountUnfollowFlags(
 
 func ParseAccountUnmuteFlags(
 	accountName *string,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -219,11 +308,13 @@ func ParseAccountUnmuteFlags(
 func ParseAccountsAddToListFlags(
 	listId *string,
 	accountName *internalFlag.MultiStringValue,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(listId, flagListId, "", "")
 	flagset.Var(accountName, flagAccountName, "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -235,11 +326,13 @@ func ParseAccountsAddToListFlags(
 func ParseAccountsRemoveFromListFlags(
 	listId *string,
 	accountName *internalFlag.MultiStringValue,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(listId, flagListId, "", "")
 	flagset.Var(accountName, flagAccountName, "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -310,12 +403,48 @@ func ParseAliasRenameFlags(
 	return nil
 }
 
+func ParseBlockedAccountsExportFlags(
+	file *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseBlockedAccountsImportFlags(
+	file *string,
+	dryRun *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+	flagset.BoolVar(dryRun, flagDryRun, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseBlockedAccountsShowFlags(
 	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -326,10 +455,237 @@ func ParseBlockedAccountsShowFlags(
 
 func ParseBookmarksShowFlags(
 	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseConversationDeleteFlags(
+	conversationId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(conversationId, flagConversationId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseConversationReadFlags(
+	conversationId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(conversationId, flagConversationId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseConversationReplyFlags(
+	conversationId *string,
+	content *string,
+	contentType *internalFlag.EnumValue,
+	sensitive *internalFlag.BoolValue,
+	summary *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(conversationId, flagConversationId, "", "")
+	flagset.StringVar(content, flagContent, "", "")
+	*contentType = internalFlag.NewEnumValue(
+		[]string{
+			"plain",
+			"markdown",
+		},
+		"plain",
+	)
+
+	flagset.Var(contentType, flagContentType, "")
+	*sensitive = internalFlag.NewBoolValue(false)
+	flagset.Var(sensitive, flagSensitive, "")
+	flagset.StringVar(summary, flagSummary, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseConversationsShowFlags(
+	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseDraftCreateFlags(
+	addPoll *bool,
+	attachmentId *internalFlag.MultiStringValue,
+	canFavouriteAlways *internalFlag.MultiStringValue,
+	canFavouriteWithApproval *internalFlag.MultiStringValue,
+	canReblogAlways *internalFlag.MultiStringValue,
+	canReblogWithApproval *internalFlag.MultiStringValue,
+	canReplyAlways *internalFlag.MultiStringValue,
+	canReplyWithApproval *internalFlag.MultiStringValue,
+	content *string,
+	contentType *internalFlag.EnumValue,
+	inReplyTo *string,
+	language *string,
+	localOnly *bool,
+	mediaDescription *internalFlag.MultiStringValue,
+	mediaFile *internalFlag.MultiStringValue,
+	mediaFocus *internalFlag.MultiStringValue,
+	notBoostable *bool,
+	notLikeable *bool,
+	notReplyable *bool,
+	pollAllowsMultipleChoices *bool,
+	pollExpiresIn *internalFlag.TimeDurationValue,
+	pollHidesVoteCounts *bool,
+	pollOption *internalFlag.MultiStringValue,
+	scheduledAt *internalFlag.TimeValue,
+	sensitive *internalFlag.BoolValue,
+	summary *string,
+	visibility *internalFlag.EnumValue,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.BoolVar(addPoll, flagAddPoll, false, "")
+	flagset.Var(attachmentId, flagAttachmentId, "")
+	flagset.Var(canFavouriteAlways, flagCanFavouriteAlways, "")
+	flagset.Var(canFavouriteWithApproval, flagCanFavouriteWithApproval, "")
+	flagset.Var(canReblogAlways, flagCanReblogAlways, "")
+	flagset.Var(canReblogWithApproval, flagCanReblogWithApproval, "")
+	flagset.Var(canReplyAlways, flagCanReplyAlways, "")
+	flagset.Var(canReplyWithApproval, flagCanReplyWithApproval, "")
+	flagset.StringVar(content, flagContent, "", "")
+	*contentType = internalFlag.NewEnumValue(
+		[]string{
+			"plain",
+			"markdown",
+		},
+		"plain",
+	)
+
+	flagset.Var(contentType, flagContentType, "")
+	flagset.StringVar(inReplyTo, flagInReplyTo, "", "")
+	flagset.StringVar(language, flagLanguage, "", "")
+	flagset.BoolVar(localOnly, flagLocalOnly, false, "")
+	flagset.Var(mediaDescription, flagMediaDescription, "")
+	flagset.Var(mediaFile, flagMediaFile, "")
+	flagset.Var(mediaFocus, flagMediaFocus, "")
+	flagset.BoolVar(notBoostable, flagNotBoostable, false, "")
+	flagset.BoolVar(notLikeable, flagNotLikeable, false, "")
+	flagset.BoolVar(notReplyable, flagNotReplyable, false, "")
+	flagset.BoolVar(pollAllowsMultipleChoices, flagPollAllowsMultipleChoices, false, "")
+	flagset.Var(pollExpiresIn, flagPollExpiresIn, "")
+	flagset.BoolVar(pollHidesVoteCounts, flagPollHidesVoteCounts, false, "")
+	flagset.Var(pollOption, flagPollOption, "")
+	flagset.Var(scheduledAt, flagScheduledAt, "")
+	*sensitive = internalFlag.NewBoolValue(false)
+	flagset.Var(sensitive, flagSensitive, "")
+	flagset.StringVar(summary, flagSummary, "", "")
+	*visibility = internalFlag.NewEnumValue(
+		[]string{
+			"public",
+			"private",
+			"unlisted",
+			"mutuals_only",
+			"direct",
+		},
+		"",
+	)
+
+	flagset.Var(visibility, flagVisibility, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseDraftDeleteFlags(
+	draftId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(draftId, flagDraftId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseDraftEditFlags(
+	draftId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(draftId, flagDraftId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseDraftPublishFlags(
+	draftId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(draftId, flagDraftId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseDraftShowFlags(
+	draftId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(draftId, flagDraftId, "", "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -340,10 +696,16 @@ func ParseBookmarksShowFlags(
 
 func ParseFavouritesShowFlags(
 	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -597,10 +959,16 @@ func ParseFollowRequestRejectFlags(
 
 func ParseFollowRequestsShowFlags(
 	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -613,12 +981,48 @@ func ParseFollowersShowFromAccountFlags(
 	accountName *string,
 	limit *int,
 	myAccount *bool,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
 	flagset.IntVar(limit, flagLimit, 20, "")
 	flagset.BoolVar(myAccount, flagMyAccount, false, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseFollowingsExportFlags(
+	file *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseFollowingsImportFlags(
+	file *string,
+	dryRun *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+	flagset.BoolVar(dryRun, flagDryRun, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -631,12 +1035,130 @@ func ParseFollowingsShowFromAccountFlags(
 	accountName *string,
 	limit *int,
 	myAccount *bool,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(accountName, flagAccountName, "", "")
 	flagset.IntVar(limit, flagLimit, 20, "")
 	flagset.BoolVar(myAccount, flagMyAccount, false, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseInteractionPoliciesEditFlags(
+	statusVisibility *internalFlag.EnumValue,
+	canFavouriteAlways *internalFlag.MultiStringValue,
+	canFavouriteWithApproval *internalFlag.MultiStringValue,
+	canReblogAlways *internalFlag.MultiStringValue,
+	canReblogWithApproval *internalFlag.MultiStringValue,
+	canReplyAlways *internalFlag.MultiStringValue,
+	canReplyWithApproval *internalFlag.MultiStringValue,
+	reset *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	*statusVisibility = internalFlag.NewEnumValue(
+		[]string{
+			"public",
+			"unlisted",
+			"private",
+			"direct",
+		},
+		"",
+	)
+
+	flagset.Var(statusVisibility, flagStatusVisibility, "")
+	flagset.Var(canFavouriteAlways, flagCanFavouriteAlways, "")
+	flagset.Var(canFavouriteWithApproval, flagCanFavouriteWithApproval, "")
+	flagset.Var(canReblogAlways, flagCanReblogAlways, "")
+	flagset.Var(canReblogWithApproval, flagCanReblogWithApproval, "")
+	flagset.Var(canReplyAlways, flagCanReplyAlways, "")
+	flagset.Var(canReplyWithApproval, flagCanReplyWithApproval, "")
+	flagset.BoolVar(reset, flagReset, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseInteractionRequestAcceptFlags(
+	interactionRequestId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(interactionRequestId, flagInteractionRequestId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseInteractionRequestRejectFlags(
+	interactionRequestId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(interactionRequestId, flagInteractionRequestId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseInteractionRequestShowFlags(
+	interactionRequestId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(interactionRequestId, flagInteractionRequestId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseInteractionRequestsShowFlags(
+	interactionType *internalFlag.MultiEnumValue,
+	statusId *string,
+	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	*interactionType = internalFlag.NewMultiEnumValue(
+		[]string{
+			"favourite",
+			"reblog",
+			"reply",
+		},
+	)
+
+	flagset.Var(interactionType, flagInteractionType, "")
+	flagset.StringVar(statusId, flagStatusId, "", "")
+	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -730,6 +1252,36 @@ func ParseListShowFlags(
 	return nil
 }
 
+func ParseListsExportFlags(
+	file *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseListsImportFlags(
+	file *string,
+	dryRun *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+	flagset.BoolVar(dryRun, flagDryRun, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseMediaShowFromStatusFlags(
 	statusId *string,
 	attachmentId *internalFlag.MultiStringValue,
@@ -802,12 +1354,64 @@ func ParseMediaAttachmentShowFlags(
 	return nil
 }
 
+func ParseMediaCacheClearFlags(
+	olderThan *internalFlag.TimeDurationValue,
+	maxSize *int,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.Var(olderThan, flagOlderThan, "")
+	flagset.IntVar(maxSize, flagMaxSize, 0, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseMutedAccountsExportFlags(
+	file *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseMutedAccountsImportFlags(
+	file *string,
+	dryRun *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(file, flagFile, "", "")
+	flagset.BoolVar(dryRun, flagDryRun, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseMutedAccountsShowFlags(
 	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -846,6 +1450,20 @@ func ParseNoteRemoveFromAccountFlags(
 	return nil
 }
 
+func ParseNotificationDismissFlags(
+	notificationId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(notificationId, flagNotificationId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseNotificationShowFlags(
 	notificationId *string,
 	flags []string,
@@ -860,10 +1478,144 @@ func ParseNotificationShowFlags(
 	return nil
 }
 
+func ParseNotificationPolicyEditFlags(
+	forNotFollowing *internalFlag.EnumValue,
+	forNotFollowers *internalFlag.EnumValue,
+	forNewAccounts *internalFlag.EnumValue,
+	forPrivateMentions *internalFlag.EnumValue,
+	forLimitedAccounts *internalFlag.EnumValue,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	*forNotFollowing = internalFlag.NewEnumValue(
+		[]string{
+			"accept",
+			"filter",
+			"drop",
+		},
+		"",
+	)
+
+	flagset.Var(forNotFollowing, flagForNotFollowing, "")
+	*forNotFollowers = internalFlag.NewEnumValue(
+		[]string{
+			"accept",
+			"filter",
+			"drop",
+		},
+		"",
+	)
+
+	flagset.Var(forNotFollowers, flagForNotFollowers, "")
+	*forNewAccounts = internalFlag.NewEnumValue(
+		[]string{
+			"accept",
+			"filter",
+			"drop",
+		},
+		"",
+	)
+
+	flagset.Var(forNewAccounts, flagForNewAccounts, "")
+	*forPrivateMentions = internalFlag.NewEnumValue(
+		[]string{
+			"accept",
+			"filter",
+			"drop",
+		},
+		"",
+	)
+
+	flagset.Var(forPrivateMentions, flagForPrivateMentions, "")
+	*forLimitedAccounts = internalFlag.NewEnumValue(
+		[]string{
+			"accept",
+			"filter",
+			"drop",
+		},
+		"",
+	)
+
+	flagset.Var(forLimitedAccounts, flagForLimitedAccounts, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseNotificationRequestAcceptFlags(
+	notificationRequestId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(notificationRequestId, flagNotificationRequestId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseNotificationRequestDismissFlags(
+	notificationRequestId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(notificationRequestId, flagNotificationRequestId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseNotificationRequestShowFlags(
+	notificationRequestId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(notificationRequestId, flagNotificationRequestId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseNotificationRequestsShowFlags(
+	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseNotificationsShowFlags(
 	limit *int,
 	excludeNotificationType *internalFlag.MultiEnumValue,
 	includeNotificationType *internalFlag.MultiEnumValue,
+	maxId *string,
+	sinceId *string,
+	all *bool,
+	unread *bool,
+	group *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
@@ -894,6 +1646,75 @@ func ParseNotificationsShowFlags(
 	)
 
 	flagset.Var(includeNotificationType, flagIncludeNotificationType, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+	flagset.BoolVar(unread, flagUnread, false, "")
+	flagset.BoolVar(group, flagGroup, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseScheduledStatusDeleteFlags(
+	scheduledStatusId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(scheduledStatusId, flagScheduledStatusId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseScheduledStatusEditFlags(
+	scheduledStatusId *string,
+	scheduledAt *internalFlag.TimeValue,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(scheduledStatusId, flagScheduledStatusId, "", "")
+	flagset.Var(scheduledAt, flagScheduledAt, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseScheduledStatusShowFlags(
+	scheduledStatusId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(scheduledStatusId, flagScheduledStatusId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseScheduledStatusesShowFlags(
+	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -933,6 +1754,12 @@ func ParseStatusAddToBookmarksFlags(
 func ParseStatusCreateFlags(
 	addPoll *bool,
 	attachmentId *internalFlag.MultiStringValue,
+	canFavouriteAlways *internalFlag.MultiStringValue,
+	canFavouriteWithApproval *internalFlag.MultiStringValue,
+	canReblogAlways *internalFlag.MultiStringValue,
+	canReblogWithApproval *internalFlag.MultiStringValue,
+	canReplyAlways *internalFlag.MultiStringValue,
+	canReplyWithApproval *internalFlag.MultiStringValue,
 	content *string,
 	contentType *internalFlag.EnumValue,
 	inReplyTo *string,
@@ -948,6 +1775,7 @@ func ParseStatusCreateFlags(
 	pollExpiresIn *internalFlag.TimeDurationValue,
 	pollHidesVoteCounts *bool,
 	pollOption *internalFlag.MultiStringValue,
+	scheduledAt *internalFlag.TimeValue,
 	sensitive *internalFlag.BoolValue,
 	summary *string,
 	visibility *internalFlag.EnumValue,
@@ -956,6 +1784,12 @@ func ParseStatusCreateFlags(
 	flagset := newFlagset()
 	flagset.BoolVar(addPoll, flagAddPoll, false, "")
 	flagset.Var(attachmentId, flagAttachmentId, "")
+	flagset.Var(canFavouriteAlways, flagCanFavouriteAlways, "")
+	flagset.Var(canFavouriteWithApproval, flagCanFavouriteWithApproval, "")
+	flagset.Var(canReblogAlways, flagCanReblogAlways, "")
+	flagset.Var(canReblogWithApproval, flagCanReblogWithApproval, "")
+	flagset.Var(canReplyAlways, flagCanReplyAlways, "")
+	flagset.Var(canReplyWithApproval, flagCanReplyWithApproval, "")
 	flagset.StringVar(content, flagContent, "", "")
 	*contentType = internalFlag.NewEnumValue(
 		[]string{
@@ -979,6 +1813,7 @@ func ParseStatusCreateFlags(
 	flagset.Var(pollExpiresIn, flagPollExpiresIn, "")
 	flagset.BoolVar(pollHidesVoteCounts, flagPollHidesVoteCounts, false, "")
 	flagset.Var(pollOption, flagPollOption, "")
+	flagset.Var(scheduledAt, flagScheduledAt, "")
 	*sensitive = internalFlag.NewBoolValue(false)
 	flagset.Var(sensitive, flagSensitive, "")
 	flagset.StringVar(summary, flagSummary, "", "")
@@ -1005,11 +1840,27 @@ func ParseStatusCreateFlags(
 func ParseStatusDeleteFlags(
 	statusId *string,
 	saveText *bool,
+	fromStdin *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.StringVar(statusId, flagStatusId, "", "")
 	flagset.BoolVar(saveText, flagSaveText, false, "")
+	flagset.BoolVar(fromStdin, flagFromStdin, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseStatusEditFlags(
+	statusId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(statusId, flagStatusId, "", "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -1066,6 +1917,20 @@ func ParseStatusMuteFlags(
 	return nil
 }
 
+func ParseStatusPinFlags(
+	statusId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(statusId, flagStatusId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseStatusReblogFlags(
 	statusId *string,
 	flags []string,
@@ -1142,6 +2007,20 @@ func ParseStatusUnmuteFlags(
 	return nil
 }
 
+func ParseStatusUnpinFlags(
+	statusId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(statusId, flagStatusId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseStatusUnreblogFlags(
 	statusId *string,
 	flags []string,
@@ -1156,6 +2035,76 @@ func ParseStatusUnreblogFlags(
 	return nil
 }
 
+func ParseStatusHistoryShowFlags(
+	statusId *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(statusId, flagStatusId, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseStatusesExportFlags(
+	accountName *string,
+	myAccount *bool,
+	outputDir *string,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(accountName, flagAccountName, "", "")
+	flagset.BoolVar(myAccount, flagMyAccount, false, "")
+	flagset.StringVar(outputDir, flagOutputDir, "", "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseStatusesPruneFlags(
+	olderThan *internalFlag.TimeDurationValue,
+	keepPinned *bool,
+	keepBookmarked *bool,
+	keepLikesOver *int,
+	keepBoostsOver *int,
+	keepVisibility *internalFlag.MultiEnumValue,
+	keepTag *internalFlag.MultiStringValue,
+	confirm *bool,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.Var(olderThan, flagOlderThan, "")
+	flagset.BoolVar(keepPinned, flagKeepPinned, false, "")
+	flagset.BoolVar(keepBookmarked, flagKeepBookmarked, false, "")
+	flagset.IntVar(keepLikesOver, flagKeepLikesOver, -1, "")
+	flagset.IntVar(keepBoostsOver, flagKeepBoostsOver, -1, "")
+	*keepVisibility = internalFlag.NewMultiEnumValue(
+		[]string{
+			"public",
+			"private",
+			"unlisted",
+			"mutuals_only",
+			"direct",
+		},
+	)
+
+	flagset.Var(keepVisibility, flagKeepVisibility, "")
+	flagset.Var(keepTag, flagKeepTag, "")
+	flagset.BoolVar(confirm, flagConfirm, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
 func ParseTagFindFlags(
 	query *string,
 	limit *int,
@@ -1216,10 +2165,16 @@ func ParseTagUnfollowFlags(
 
 func ParseTagsShowFlags(
 	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
@@ -1247,6 +2202,10 @@ func ParseTimelineShowFlags(
 	listId *string,
 	tagName *string,
 	timelineCategory *internalFlag.EnumValue,
+	maxId *string,
+	sinceId *string,
+	all *bool,
+	unread *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
@@ -1263,6 +2222,38 @@ func ParseTimelineShowFlags(
 		"home",
 	)
 
+	flagset.Var(timelineCategory, flagTimelineCategory, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+	flagset.BoolVar(unread, flagUnread, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseTimelineWatchFlags(
+	listId *string,
+	tagName *string,
+	timelineCategory *internalFlag.EnumValue,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(listId, flagListId, "", "")
+	flagset.StringVar(tagName, flagTagName, "", "")
+	*timelineCategory = internalFlag.NewEnumValue(
+		[]string{
+			"home",
+			"list",
+			"public",
+			"tag",
+		},
+		"home",
+	)
+
 	flagset.Var(timelineCategory, flagTimelineCategory, "")
 
 	if err := flagset.Parse(flags); err != nil {
@@ -1302,10 +2293,45 @@ func ParseTokenShowFlags(
 
 func ParseTokensShowFlags(
 	limit *int,
+	maxId *string,
+	sinceId *string,
+	all *bool,
 	flags []string,
 ) error {
 	flagset := newFlagset()
 	flagset.IntVar(limit, flagLimit, 20, "")
+	flagset.StringVar(maxId, flagMaxId, "", "")
+	flagset.StringVar(sinceId, flagSinceId, "", "")
+	flagset.BoolVar(all, flagAll, false, "")
+
+	if err := flagset.Parse(flags); err != nil {
+		return fmt.Errorf("flag parsing error: %w", err)
+	}
+
+	return nil
+}
+
+func ParseTuiStartFlags(
+	listId *string,
+	tagName *string,
+	timelineCategory *internalFlag.EnumValue,
+	flags []string,
+) error {
+	flagset := newFlagset()
+	flagset.StringVar(listId, flagListId, "", "")
+	flagset.StringVar(tagName, flagTagName, "", "")
+	*timelineCategory = internalFlag.NewEnumValue(
+		[]string{
+			"home",
+			"list",
+			"notifications",
+			"public",
+			"tag",
+		},
+		"home",
+	)
+
+	flagset.Var(timelineCategory, flagTimelineCategory, "")
 
 	if err := flagset.Parse(flags); err != nil {
 		return fmt.Errorf("flag parsing error: %w", err)
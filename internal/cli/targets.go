@@ -6,43 +6,60 @@
 package cli
 
 const (
-	TargetAccess          string = "access"
-	TargetAccount         string = "account"
-	TargetAccounts        string = "accounts"
-	TargetAlias           string = "alias"
-	TargetAliases         string = "aliases"
-	TargetBlockedAccounts string = "blocked-accounts"
-	TargetBookmarks       string = "bookmarks"
-	TargetConfig          string = "config"
-	TargetFavourites      string = "favourites"
-	TargetFilter          string = "filter"
-	TargetFilterKeyword   string = "filter-keyword"
-	TargetFilterStatus    string = "filter-status"
-	TargetFilters         string = "filters"
-	TargetFollowRequest   string = "follow-request"
-	TargetFollowRequests  string = "follow-requests"
-	TargetFollowers       string = "followers"
-	TargetFollowings      string = "followings"
-	TargetInstance        string = "instance"
-	TargetList            string = "list"
-	TargetLists           string = "lists"
-	TargetMedia           string = "media"
-	TargetMediaAttachment string = "media-attachment"
-	TargetMutedAccounts   string = "muted-accounts"
-	TargetNote            string = "note"
-	TargetNotification    string = "notification"
-	TargetNotifications   string = "notifications"
-	TargetServer          string = "server"
-	TargetStatus          string = "status"
-	TargetTag             string = "tag"
-	TargetTags            string = "tags"
-	TargetThread          string = "thread"
-	TargetTimeline        string = "timeline"
-	TargetToken           string = "token"
-	TargetTokens          string = "tokens"
-	TargetUsage           string = "usage"
-	TargetVersion         string = "version"
-	TargetVotes           string = "votes"
+	TargetAccess               string = "access"
+	TargetAccount              string = "account"
+	TargetAccounts             string = "accounts"
+	TargetAlias                string = "alias"
+	TargetAliases              string = "aliases"
+	TargetBlockedAccounts      string = "blocked-accounts"
+	TargetBookmarks            string = "bookmarks"
+	TargetCache                string = "cache"
+	TargetConfig               string = "config"
+	TargetConversation         string = "conversation"
+	TargetConversations        string = "conversations"
+	TargetDraft                string = "draft"
+	TargetFavourites           string = "favourites"
+	TargetFilter               string = "filter"
+	TargetFilterKeyword        string = "filter-keyword"
+	TargetFilterStatus         string = "filter-status"
+	TargetFilters              string = "filters"
+	TargetFollowRequest        string = "follow-request"
+	TargetFollowRequests       string = "follow-requests"
+	TargetFollowers            string = "followers"
+	TargetFollowings           string = "followings"
+	TargetInstance             string = "instance"
+	TargetInteractionPolicies  string = "interaction-policies"
+	TargetInteractionRequest   string = "interaction-request"
+	TargetInteractionRequests  string = "interaction-requests"
+	TargetList                 string = "list"
+	TargetLists                string = "lists"
+	TargetMedia                string = "media"
+	TargetMediaAttachment      string = "media-attachment"
+	TargetMediaCache           string = "media-cache"
+	TargetMutedAccounts        string = "muted-accounts"
+	TargetNote                 string = "note"
+	TargetNotification         string = "notification"
+	TargetNotificationPolicy   string = "notification-policy"
+	TargetNotificationRequest  string = "notification-request"
+	TargetNotificationRequests string = "notification-requests"
+	TargetNotifications        string = "notifications"
+	TargetRateLimit            string = "rate-limit"
+	TargetScheduledStatus      string = "scheduled-status"
+	TargetScheduledStatuses    string = "scheduled-statuses"
+	TargetServer               string = "server"
+	TargetStatus               string = "status"
+	TargetStatusHistory        string = "status-history"
+	TargetStatuses             string = "statuses"
+	TargetTag                  string = "tag"
+	TargetTags                 string = "tags"
+	TargetThread               string = "thread"
+	TargetTimeline             string = "timeline"
+	TargetToken                string = "token"
+	TargetTokens               string = "tokens"
+	TargetTui                  string = "tui"
+	TargetUsage                string = "usage"
+	TargetVersion              string = "version"
+	TargetVotes                string = "votes"
 )
 
 // TargetActionPreposition returns the preposition word used to
@@ -10,6 +10,7 @@ func TopLevelFlagsUsageMap() map[string]string {
 	return map[string]string{
 		flagConfig:  "the path to your configuration file",
 		flagNoColor: "disable the ANSI colour output when displaying the text on screen",
+		flagOutput:  "the output format (json, jsonl or text); use json or jsonl to print the results in a machine-readable format",
 	}
 }
 
@@ -18,32 +19,74 @@ func FlagUsageMap() map[string]string {
 	return map[string]string{
 		flagAccountName:               "the name of the account to {action}",
 		flagAddPoll:                   "add a poll to the new status",
+		flagAll:                       "retrieve every page of the list, starting from the requested page",
 		flagAllAudio:                  "play all audio files from the status",
 		flagAllImages:                 "show all image files from the status",
 		flagAllVideos:                 "play all video files from the status",
 		flagAttachmentId:              "the ID of the media attachment",
+		flagAvatarDescription:         "the description of your avatar",
+		flagAvatarFile:                "the path to the image file for your avatar",
+		flagBio:                       "the biography of your account",
+		flagBot:                       "mark your account as an automated (bot) account",
 		flagBrowser:                   "{action} the {target} in your favourite browser",
+		flagCanFavouriteAlways:        "who can favourite (like) the status without approval",
+		flagCanFavouriteWithApproval:  "who can favourite (like) the status with your approval",
+		flagCanReblogAlways:           "who can reblog (boost) the status without approval",
+		flagCanReblogWithApproval:     "who can reblog (boost) the status with your approval",
+		flagCanReplyAlways:            "who can reply to the status without approval",
+		flagCanReplyWithApproval:      "who can reply to the status with your approval",
+		flagConfirm:                   "confirm the {action} without being prompted",
 		flagContent:                   "the content of the {target}",
 		flagContentType:               "the type that the contents should be parsed from",
+		flagConversationId:            "the ID of the conversation to {action}",
+		flagDefaultContentType:        "the default content type of your new statuses",
+		flagDefaultLanguage:           "the default language of your new statuses",
+		flagDefaultVisibility:         "the default visibility of your new statuses",
+		flagDiscoverable:              "allow your account to be discovered by other users",
+		flagDisplayName:               "the display name of your account",
+		flagDraftId:                   "the ID of the draft to {action}",
+		flagDryRun:                    "print the changes that would be made without making them",
 		flagDuration:                  "how long the effect should last for (set to 0s to last indefinitely)",
+		flagEditBio:                   "edit the biography of your account in your text editor",
 		flagExcludeNotificationType:   "the type of notifications to exclude from the list",
 		flagExcludeReblogs:            "exclude statuses that are reblogs (boosts) of other statuses",
 		flagExcludeReplies:            "exclude statuses that are replies to other statuses",
 		flagExclusive:                 "hide posts from members of this list from your home timeline",
+		flagFile:                      "the path to the CSV file to {action}",
 		flagFilterAction:              "the action to take when a status matches this filter",
 		flagFilterContext:             "the context in which the filter should be applied",
 		flagFilterExpiresIn:           "the time from when the filter is created that it should expire",
 		flagFilterId:                  "the ID of the filter",
 		flagFilterKeywordId:           "the ID of the filter-keyword",
 		flagFilterStatusId:            "the ID of the filter-status",
+		flagForLimitedAccounts:        "the policy for notifications from accounts that have been limited by a moderator",
+		flagForNewAccounts:            "the policy for notifications from accounts created within the past 30 days",
+		flagForNotFollowers:           "the policy for notifications from accounts that do not follow you",
+		flagForNotFollowing:           "the policy for notifications from accounts that you do not follow",
+		flagForPrivateMentions:        "the policy for unsolicited private mentions",
+		flagFromStdin:                 "read the newline-separated IDs or account names from standard input and run the action on each of them",
 		flagFull:                      "print the application's full build information",
+		flagGroup:                     "group the likes and boosts of the same status into a single entry",
+		flagHeaderDescription:         "the description of your header image",
+		flagHeaderFile:                "the path to the image file for your header image",
 		flagInReplyTo:                 "the ID of the status that you want to reply to",
 		flagIncludeNotificationType:   "the type of notifications to include in the list",
+		flagInteractionRequestId:      "the ID of the interaction request to {action}",
+		flagInteractionType:           "the type of interaction requests to include in the list",
+		flagKeepBookmarked:            "keep the statuses that you have bookmarked",
+		flagKeepBoostsOver:            "keep the statuses with more than the specified number of boosts (0 keeps every boosted status)",
+		flagKeepLikesOver:             "keep the statuses with more than the specified number of likes (0 keeps every liked status)",
+		flagKeepPinned:                "keep the statuses that are pinned to your profile",
+		flagKeepTag:                   "keep the statuses with the specified hashtag",
+		flagKeepVisibility:            "keep the statuses with the specified visibility",
 		flagKeyword:                   "the text to be filtered",
 		flagLanguage:                  "the ISO 639 language code for this {target}",
 		flagLimit:                     "the maximum number of items to display",
 		flagListId:                    "the ID of the list",
 		flagLocalOnly:                 "do not federate the status beyond the local timeline(s)",
+		flagLocked:                    "manually approve the requests to follow your account",
+		flagMaxId:                     "only show the items older than this ID (use this to view the next page of the list)",
+		flagMaxSize:                   "the maximum size (in MiB) of the {target} to keep",
 		flagMaxStatuses:               "the maximum number of statuses to display",
 		flagMediaDescription:          "the description of the media attachment",
 		flagMediaFile:                 "the path to the file of the media-attachment",
@@ -56,36 +99,47 @@ func FlagUsageMap() map[string]string {
 		flagNotLikeable:               "viewers will not be allowed to like (favourite) the created status",
 		flagNotReplyable:              "viewers will not be allowed to reply to the created status",
 		flagNotificationId:            "the ID of the notification to {action}",
+		flagNotificationRequestId:     "the ID of the notification request to {action}",
 		flagNotify:                    "get notifications whenever the account you want to follow posts a status",
 		flagOldName:                   "the old {target} name",
+		flagOlderThan:                 "the minimum age of the {target} to {action} (e.g. \"90 days\")",
 		flagOnlyMedia:                 "only show the statuses with media attachments",
 		flagOnlyPinned:                "only show the account's pinned statuses",
 		flagOnlyPublic:                "only show the account's public posts",
 		flagOperation:                 "the name of the operation",
+		flagOutputDir:                 "the directory to save the {target} to",
 		flagPollAllowsMultipleChoices: "allow viewers to make multiple choices in the poll",
 		flagPollExpiresIn:             "the time from when the poll is created that it should expire",
 		flagPollHidesVoteCounts:       "hide the vote count until the poll is closed",
 		flagPollOption:                "a poll option (use this flag multiple times to set multiple poll options)",
+		flagProfileField:              "a profile field in the format 'name=value' to add or update (your other profile fields are kept)",
 		flagQuery:                     "the search query string",
 		flagRepliesPolicy:             "the replies policy of the {target} to {action}",
+		flagReset:                     "reset the {target} of the specified visibility to the instance's defaults",
 		flagResolve:                   "allow your instance to resolve the search by making calls to remote instances",
 		flagRestrictToFollowing:       "restrict the search to accounts that you are following",
 		flagSaveText:                  "save the text of the deleted {target}",
+		flagScheduledAt:               "the time to publish the {target} (e.g. \"2025-06-01 15:30\") or how long to wait before publishing it (e.g. \"2 hours\")",
+		flagScheduledStatusId:         "the ID of the scheduled status",
 		flagScope:                     "the scope of access to your GoToSocial instance (e.g. read)",
 		flagSensitive:                 "mark the {target} as sensitive",
 		flagShowReblogs:               "show reblogs (boosts) from the account you want to follow",
 		flagShowStatuses:              "view the statuses from the {target} that you are viewing",
 		flagShowWhoFavourited:         "show the accounts who favourited (liked) the {target}",
 		flagShowWhoReblogged:          "show the accounts who reblogged (boosted) the {target}",
+		flagSinceId:                   "only show the items newer than this ID (use this to view the items posted since you last looked)",
 		flagSkipAccountRelationship:   "don't show your relationship to the account that you are viewing",
 		flagSkipUserPreferences:       "don't show your posting preferences when viewing your account information",
 		flagStatusId:                  "the ID of the status",
+		flagStatusVisibility:          "the visibility of the statuses that the {target} apply to",
 		flagSummary:                   "the summary of the status (a.k.a the subject, spoiler text or content warning)",
 		flagTagName:                   "the name of the (hash)tag",
 		flagTarget:                    "the name of the target to {action}",
+		flagTheme:                     "the theme used for your profile page",
 		flagTimelineCategory:          "the category of the timeline to {action}",
 		flagTitle:                     "the title of the {target} to {action}",
 		flagTokenId:                   "the ID of the token to {action}",
+		flagUnread:                    "show the {target} that you have not read yet, starting from your last read position",
 		flagUrl:                       "the URL of your GoToSocial instance",
 		flagVisibility:                "The visibility of the {target}",
 		flagVote:                      "the option in the poll to vote for",
@@ -108,43 +162,60 @@ func TargetDescMap() map[string]string {
 
 func targetDescMap() map[string]string {
 	return map[string]string{
-		TargetAccess:          "your access to your GoToSocial instance",
-		TargetAccount:         "a local or remote account",
-		TargetAccounts:        "one or accounts",
-		TargetAlias:           "a custom command mapped to an operation",
-		TargetAliases:         "the list of your aliases",
-		TargetBlockedAccounts: "the accounts that are blocked by you",
-		TargetBookmarks:       "the statuses that you've bookmarked",
-		TargetConfig:          "your configuration",
-		TargetFavourites:      "the statuses that you've favourited (liked)",
-		TargetFilter:          "a single filter",
-		TargetFilterKeyword:   "the text to filter within a filter",
-		TargetFilterStatus:    "the status to filter within a filter",
-		TargetFilters:         "the list of your filters",
-		TargetFollowRequest:   "the account that is requesting to follow you",
-		TargetFollowRequests:  "the list of accounts that are requesting to follow you",
-		TargetFollowers:       "the accounts who are following the specified account",
-		TargetFollowings:      "the accounts who the specified account is following",
-		TargetInstance:        "the GoToSocial instance",
-		TargetList:            "a single list",
-		TargetLists:           "one or more lists",
-		TargetMedia:           "the media attached to the specified status",
-		TargetMediaAttachment: "a media attachment that you own",
-		TargetMutedAccounts:   "the accounts that are muted by you",
-		TargetNote:            "your private note about an account",
-		TargetNotification:    "a single notification",
-		TargetNotifications:   "multiple notifications",
-		TargetServer:          "the server mode",
-		TargetStatus:          "a single status",
-		TargetTag:             "a single tag (hashtag)",
-		TargetTags:            "multiple tags (hashtags)",
-		TargetThread:          "a status thread",
-		TargetTimeline:        "your timeline",
-		TargetToken:           "details of an application token",
-		TargetTokens:          "a list of your tokens",
-		TargetUsage:           "the usage documentation",
-		TargetVersion:         "the application's build information",
-		TargetVotes:           "the votes(s) to the poll in a status",
+		TargetAccess:               "your access to your GoToSocial instance",
+		TargetAccount:              "a local or remote account",
+		TargetAccounts:             "one or accounts",
+		TargetAlias:                "a custom command mapped to an operation",
+		TargetAliases:              "the list of your aliases",
+		TargetBlockedAccounts:      "the accounts that are blocked by you",
+		TargetBookmarks:            "the statuses that you've bookmarked",
+		TargetCache:                "the cached responses from the GoToSocial instance for your account",
+		TargetConfig:               "your configuration",
+		TargetConversation:         "a private conversation with one or more accounts",
+		TargetConversations:        "your private conversations",
+		TargetDraft:                "a status that is saved locally so that it can be finished and published later",
+		TargetFavourites:           "the statuses that you've favourited (liked)",
+		TargetFilter:               "a single filter",
+		TargetFilterKeyword:        "the text to filter within a filter",
+		TargetFilterStatus:         "the status to filter within a filter",
+		TargetFilters:              "the list of your filters",
+		TargetFollowRequest:        "the account that is requesting to follow you",
+		TargetFollowRequests:       "the list of accounts that are requesting to follow you",
+		TargetFollowers:            "the accounts who are following the specified account",
+		TargetFollowings:           "the accounts who the specified account is following",
+		TargetInstance:             "the GoToSocial instance",
+		TargetInteractionPolicies:  "your default interaction policies for new statuses",
+		TargetInteractionRequest:   "a request from an account to interact with one of your statuses",
+		TargetInteractionRequests:  "the list of pending requests to interact with your statuses",
+		TargetList:                 "a single list",
+		TargetLists:                "one or more lists",
+		TargetMedia:                "the media attached to the specified status",
+		TargetMediaAttachment:      "a media attachment that you own",
+		TargetMediaCache:           "the media files downloaded from your GoToSocial instances for viewing",
+		TargetMutedAccounts:        "the accounts that are muted by you",
+		TargetNote:                 "your private note about an account",
+		TargetNotification:         "a single notification",
+		TargetNotificationPolicy:   "your policy for filtering notifications from accounts you may not know",
+		TargetNotificationRequest:  "the notifications from an account that have been filtered by your notification policy",
+		TargetNotificationRequests: "the list of accounts with filtered notifications",
+		TargetNotifications:        "multiple notifications",
+		TargetRateLimit:            "the rate limit that the GoToSocial instance applies to your requests",
+		TargetScheduledStatus:      "a status that is scheduled to be published at a later time",
+		TargetScheduledStatuses:    "the statuses that are scheduled to be published at a later time",
+		TargetServer:               "the server mode",
+		TargetStatus:               "a single status",
+		TargetStatusHistory:        "the edit history of a status",
+		TargetStatuses:             "the statuses posted by an account",
+		TargetTag:                  "a single tag (hashtag)",
+		TargetTags:                 "multiple tags (hashtags)",
+		TargetThread:               "a status thread",
+		TargetTimeline:             "your timeline",
+		TargetToken:                "details of an application token",
+		TargetTokens:               "a list of your tokens",
+		TargetTui:                  "the interactive terminal user interface",
+		TargetUsage:                "the usage documentation",
+		TargetVersion:              "the application's build information",
+		TargetVotes:                "the votes(s) to the poll in a status",
 	}
 }
 
@@ -195,6 +266,28 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Description: "blocks a local or remote account",
 				Flags: []string{
 					flagAccountName,
+					flagFromStdin,
+				},
+			},
+			"edit account": {
+				Description: "edits the profile and the settings of your account",
+				Flags: []string{
+					flagMyAccount,
+					flagDisplayName,
+					flagBio,
+					flagEditBio,
+					flagProfileField,
+					flagAvatarFile,
+					flagAvatarDescription,
+					flagHeaderFile,
+					flagHeaderDescription,
+					flagLocked,
+					flagBot,
+					flagDiscoverable,
+					flagDefaultVisibility,
+					flagDefaultLanguage,
+					flagDefaultContentType,
+					flagTheme,
 				},
 			},
 			"find account": {
@@ -212,6 +305,7 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagAccountName,
 					flagNotify,
 					flagShowReblogs,
+					flagFromStdin,
 				},
 			},
 			"mute account": {
@@ -220,6 +314,7 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagAccountName,
 					flagDuration,
 					flagMuteNotifications,
+					flagFromStdin,
 				},
 			},
 			"show account": {
@@ -243,18 +338,21 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Description: "unblocks an account",
 				Flags: []string{
 					flagAccountName,
+					flagFromStdin,
 				},
 			},
 			"unfollow account": {
 				Description: "unfollows the account that you are curently following",
 				Flags: []string{
 					flagAccountName,
+					flagFromStdin,
 				},
 			},
 			"unmute account": {
 				Description: "unmutes an account",
 				Flags: []string{
 					flagAccountName,
+					flagFromStdin,
 				},
 			},
 		},
@@ -264,6 +362,7 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Flags: []string{
 					flagListId,
 					flagAccountName,
+					flagFromStdin,
 				},
 			},
 			"remove accounts from list": {
@@ -271,6 +370,7 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Flags: []string{
 					flagListId,
 					flagAccountName,
+					flagFromStdin,
 				},
 			},
 		},
@@ -310,10 +410,26 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 			},
 		},
 		TargetBlockedAccounts: {
+			"export blocked-accounts": {
+				Description: "saves the accounts that you have blocked to a CSV file",
+				Flags: []string{
+					flagFile,
+				},
+			},
+			"import blocked-accounts": {
+				Description: "blocks the accounts from a CSV file",
+				Flags: []string{
+					flagFile,
+					flagDryRun,
+				},
+			},
 			"show blocked-accounts": {
 				Description: "prints the list of the accounts that you are currently blocking",
 				Flags: []string{
 					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
@@ -322,20 +438,129 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Description: "prints the list of the statuses that you have bookmarked",
 				Flags: []string{
 					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
+		TargetCache: {
+			"clear cache": {
+				Description: "removes all the cached responses for your account",
+				Flags:       []string{},
+			},
+			"show cache": {
+				Description: "prints the list of cached responses for your account",
+				Flags:       []string{},
+			},
+		},
 		TargetConfig: {
 			"create config": {
 				Description: "creates a new configuration file",
 				Flags:       []string{},
 			},
 		},
+		TargetConversation: {
+			"delete conversation": {
+				Description: "removes the conversation from your list of conversations",
+				Flags: []string{
+					flagConversationId,
+				},
+			},
+			"read conversation": {
+				Description: "marks the conversation as read",
+				Flags: []string{
+					flagConversationId,
+				},
+			},
+			"reply conversation": {
+				Description: "replies to the last status in the conversation with a direct status to all the participants",
+				Flags: []string{
+					flagConversationId,
+					flagContent,
+					flagContentType,
+					flagSensitive,
+					flagSummary,
+				},
+			},
+		},
+		TargetConversations: {
+			"show conversations": {
+				Description: "prints the list of your conversations with the unread conversations listed first",
+				Flags: []string{
+					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
+				},
+			},
+		},
+		TargetDraft: {
+			"create draft": {
+				Description: "saves the options for a new status as a draft",
+				Flags: []string{
+					flagAddPoll,
+					flagAttachmentId,
+					flagCanFavouriteAlways,
+					flagCanFavouriteWithApproval,
+					flagCanReblogAlways,
+					flagCanReblogWithApproval,
+					flagCanReplyAlways,
+					flagCanReplyWithApproval,
+					flagContent,
+					flagContentType,
+					flagInReplyTo,
+					flagLanguage,
+					flagLocalOnly,
+					flagMediaDescription,
+					flagMediaFile,
+					flagMediaFocus,
+					flagNotBoostable,
+					flagNotLikeable,
+					flagNotReplyable,
+					flagPollAllowsMultipleChoices,
+					flagPollExpiresIn,
+					flagPollHidesVoteCounts,
+					flagPollOption,
+					flagScheduledAt,
+					flagSensitive,
+					flagSummary,
+					flagVisibility,
+				},
+			},
+			"delete draft": {
+				Description: "deletes the draft",
+				Flags: []string{
+					flagDraftId,
+				},
+			},
+			"edit draft": {
+				Description: "edits the draft in your text editor",
+				Flags: []string{
+					flagDraftId,
+				},
+			},
+			"publish draft": {
+				Description: "creates the status from the draft and deletes the draft",
+				Flags: []string{
+					flagDraftId,
+				},
+			},
+			"show draft": {
+				Description: "prints the details of the draft",
+				Flags: []string{
+					flagDraftId,
+				},
+			},
+		},
 		TargetFavourites: {
 			"show favourites": {
 				Description: "prints the list of statuses that you've favourited (liked)",
 				Flags: []string{
 					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
@@ -448,6 +673,9 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Description: "prints the list of accounts that are requesting to follow you",
 				Flags: []string{
 					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
@@ -458,16 +686,35 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagAccountName,
 					flagLimit,
 					flagMyAccount,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
 		TargetFollowings: {
+			"export followings": {
+				Description: "saves the accounts that you are following to a CSV file",
+				Flags: []string{
+					flagFile,
+				},
+			},
+			"import followings": {
+				Description: "follows the accounts from a CSV file",
+				Flags: []string{
+					flagFile,
+					flagDryRun,
+				},
+			},
 			"show followings from account": {
 				Description: "prints the names of the accounts that the specified account is following",
 				Flags: []string{
 					flagAccountName,
 					flagLimit,
 					flagMyAccount,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
@@ -477,6 +724,58 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Flags:       []string{},
 			},
 		},
+		TargetInteractionPolicies: {
+			"edit interaction-policies": {
+				Description: "edits your default interaction policy for the statuses of the specified visibility",
+				Flags: []string{
+					flagStatusVisibility,
+					flagCanFavouriteAlways,
+					flagCanFavouriteWithApproval,
+					flagCanReblogAlways,
+					flagCanReblogWithApproval,
+					flagCanReplyAlways,
+					flagCanReplyWithApproval,
+					flagReset,
+				},
+			},
+			"show interaction-policies": {
+				Description: "prints your default interaction policies for each visibility",
+				Flags:       []string{},
+			},
+		},
+		TargetInteractionRequest: {
+			"accept interaction-request": {
+				Description: "accepts the interaction request so that the interaction with your status is approved",
+				Flags: []string{
+					flagInteractionRequestId,
+				},
+			},
+			"reject interaction-request": {
+				Description: "rejects the interaction request so that the interaction with your status is denied",
+				Flags: []string{
+					flagInteractionRequestId,
+				},
+			},
+			"show interaction-request": {
+				Description: "prints the details of the interaction request",
+				Flags: []string{
+					flagInteractionRequestId,
+				},
+			},
+		},
+		TargetInteractionRequests: {
+			"show interaction-requests": {
+				Description: "prints the list of pending requests to reply to, reblog (boost) or favourite (like) your statuses",
+				Flags: []string{
+					flagInteractionType,
+					flagStatusId,
+					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
+				},
+			},
+		},
 		TargetList: {
 			"create list": {
 				Description: "creates a new list",
@@ -509,6 +808,19 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 			},
 		},
 		TargetLists: {
+			"export lists": {
+				Description: "saves your lists and their members to a CSV file",
+				Flags: []string{
+					flagFile,
+				},
+			},
+			"import lists": {
+				Description: "adds the accounts to the lists from a CSV file",
+				Flags: []string{
+					flagFile,
+					flagDryRun,
+				},
+			},
 			"show lists": {
 				Description: "prints the ID and the title of the lists that you've created",
 				Flags:       []string{},
@@ -550,11 +862,40 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				},
 			},
 		},
+		TargetMediaCache: {
+			"clear media-cache": {
+				Description: "removes the downloaded media files from the media cache of every instance",
+				Flags: []string{
+					flagOlderThan,
+					flagMaxSize,
+				},
+			},
+			"show media-cache": {
+				Description: "prints the size of the media cache of each instance",
+				Flags:       []string{},
+			},
+		},
 		TargetMutedAccounts: {
+			"export muted-accounts": {
+				Description: "saves the accounts that you have muted to a CSV file",
+				Flags: []string{
+					flagFile,
+				},
+			},
+			"import muted-accounts": {
+				Description: "mutes the accounts from a CSV file",
+				Flags: []string{
+					flagFile,
+					flagDryRun,
+				},
+			},
 			"show muted-accounts": {
 				Description: "prints the list of accounts that you have muted",
 				Flags: []string{
 					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
@@ -574,6 +915,12 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 			},
 		},
 		TargetNotification: {
+			"dismiss notification": {
+				Description: "dismisses a single notification",
+				Flags: []string{
+					flagNotificationId,
+				},
+			},
 			"show notification": {
 				Description: "prints the details of the specified notification",
 				Flags: []string{
@@ -581,6 +928,53 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				},
 			},
 		},
+		TargetNotificationPolicy: {
+			"edit notification-policy": {
+				Description: "edits your notification policy",
+				Flags: []string{
+					flagForNotFollowing,
+					flagForNotFollowers,
+					flagForNewAccounts,
+					flagForPrivateMentions,
+					flagForLimitedAccounts,
+				},
+			},
+			"show notification-policy": {
+				Description: "prints your notification policy and the number of pending notification requests",
+				Flags:       []string{},
+			},
+		},
+		TargetNotificationRequest: {
+			"accept notification-request": {
+				Description: "accepts the notification request so that the account's filtered notifications are moved to your notifications",
+				Flags: []string{
+					flagNotificationRequestId,
+				},
+			},
+			"dismiss notification-request": {
+				Description: "dismisses the notification request and the account's filtered notifications",
+				Flags: []string{
+					flagNotificationRequestId,
+				},
+			},
+			"show notification-request": {
+				Description: "prints the details of the notification request",
+				Flags: []string{
+					flagNotificationRequestId,
+				},
+			},
+		},
+		TargetNotificationRequests: {
+			"show notification-requests": {
+				Description: "prints the list of accounts whose notifications have been filtered by your notification policy",
+				Flags: []string{
+					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
+				},
+			},
+		},
 		TargetNotifications: {
 			"clear notifications": {
 				Description: "clears all your notifications",
@@ -592,10 +986,61 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagLimit,
 					flagExcludeNotificationType,
 					flagIncludeNotificationType,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
+					flagUnread,
+					flagGroup,
+				},
+			},
+			"watch notifications": {
+				Description: "streams your notifications as they arrive",
+				Flags:       []string{},
+			},
+		},
+		TargetRateLimit: {
+			"show rate-limit": {
+				Description: "prints the rate limit reported by the GoToSocial instance that you have logged into",
+				Flags:       []string{},
+			},
+		},
+		TargetScheduledStatus: {
+			"delete scheduled-status": {
+				Description: "deletes the scheduled status so that it is not published",
+				Flags: []string{
+					flagScheduledStatusId,
+				},
+			},
+			"edit scheduled-status": {
+				Description: "changes the time that the scheduled status is published",
+				Flags: []string{
+					flagScheduledStatusId,
+					flagScheduledAt,
+				},
+			},
+			"show scheduled-status": {
+				Description: "prints the details of the scheduled status",
+				Flags: []string{
+					flagScheduledStatusId,
+				},
+			},
+		},
+		TargetScheduledStatuses: {
+			"show scheduled-statuses": {
+				Description: "prints the list of your scheduled statuses",
+				Flags: []string{
+					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
 		TargetServer: {
+			"show server": {
+				Description: "prints the usage statistics of the in-memory caches of the running server",
+				Flags:       []string{},
+			},
 			"start server": {
 				Description: "starts enbas in the server mode",
 				Flags: []string{
@@ -615,6 +1060,12 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Flags: []string{
 					flagAddPoll,
 					flagAttachmentId,
+					flagCanFavouriteAlways,
+					flagCanFavouriteWithApproval,
+					flagCanReblogAlways,
+					flagCanReblogWithApproval,
+					flagCanReplyAlways,
+					flagCanReplyWithApproval,
 					flagContent,
 					flagContentType,
 					flagInReplyTo,
@@ -630,6 +1081,7 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagPollExpiresIn,
 					flagPollHidesVoteCounts,
 					flagPollOption,
+					flagScheduledAt,
 					flagSensitive,
 					flagSummary,
 					flagVisibility,
@@ -640,6 +1092,13 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Flags: []string{
 					flagStatusId,
 					flagSaveText,
+					flagFromStdin,
+				},
+			},
+			"edit status": {
+				Description: "edits the content, summary, sensitivity, media descriptions and poll options of your status in your text editor",
+				Flags: []string{
+					flagStatusId,
 				},
 			},
 			"favourite status": {
@@ -663,6 +1122,12 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagStatusId,
 				},
 			},
+			"pin status": {
+				Description: "pins one of your statuses to the top of your profile",
+				Flags: []string{
+					flagStatusId,
+				},
+			},
 			"reblog status": {
 				Description: "reblogs (boosts) the specified status",
 				Flags: []string{
@@ -696,6 +1161,12 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagStatusId,
 				},
 			},
+			"unpin status": {
+				Description: "unpins one of your pinned statuses from your profile",
+				Flags: []string{
+					flagStatusId,
+				},
+			},
 			"unreblog status": {
 				Description: "unreblogs (unboosts) the specified status",
 				Flags: []string{
@@ -703,6 +1174,37 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				},
 			},
 		},
+		TargetStatusHistory: {
+			"show status-history": {
+				Description: "prints every revision of the specified status",
+				Flags: []string{
+					flagStatusId,
+				},
+			},
+		},
+		TargetStatuses: {
+			"export statuses": {
+				Description: "saves all the statuses posted by the account (including replies and boosts) to an offline archive",
+				Flags: []string{
+					flagAccountName,
+					flagMyAccount,
+					flagOutputDir,
+				},
+			},
+			"prune statuses": {
+				Description: "deletes your statuses that are older than the specified age",
+				Flags: []string{
+					flagOlderThan,
+					flagKeepPinned,
+					flagKeepBookmarked,
+					flagKeepLikesOver,
+					flagKeepBoostsOver,
+					flagKeepVisibility,
+					flagKeepTag,
+					flagConfirm,
+				},
+			},
+		},
 		TargetTag: {
 			"find tag": {
 				Description: "searches for a tag",
@@ -735,6 +1237,9 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Description: "prints the list of the tags that you are following",
 				Flags: []string{
 					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
 				},
 			},
 		},
@@ -754,6 +1259,18 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 					flagListId,
 					flagTagName,
 					flagTimelineCategory,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
+					flagUnread,
+				},
+			},
+			"watch timeline": {
+				Description: "streams new statuses from a timeline as they are posted",
+				Flags: []string{
+					flagListId,
+					flagTagName,
+					flagTimelineCategory,
 				},
 			},
 		},
@@ -776,6 +1293,19 @@ func usageOperationMap() map[string]map[string]UsageOperation {
 				Description: "prints the list of the tokens that you have created",
 				Flags: []string{
 					flagLimit,
+					flagMaxId,
+					flagSinceId,
+					flagAll,
+				},
+			},
+		},
+		TargetTui: {
+			"start tui": {
+				Description: "starts the interactive terminal user interface for browsing your timelines",
+				Flags: []string{
+					flagListId,
+					flagTagName,
+					flagTimelineCategory,
 				},
 			},
 		},
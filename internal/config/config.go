@@ -13,18 +13,24 @@ const (
 	defaultHTTPMediaTimeout  int = 30
 	defaultLineWrapMaxWidth  int = 80
 	defaultServerIdleTimeout int = 300
+	defaultNotifierInterval  int = 60
+	defaultCacheTTLAccounts  int = 60
+	defaultCacheTTLInstance  int = 3600
+	defaultCacheTTLLists     int = 300
+	defaultCacheTTL          int = 0
 )
 
 type Config struct {
-	populated        bool
-	Path             string            `json:"-"`
-	Aliases          map[string]string `json:"aliases"`
-	CredentialsFile  string            `json:"credentialsFile"`
-	CacheDirectory   string            `json:"cacheDirectory"`
-	LineWrapMaxWidth int               `json:"lineWrapMaxWidth"`
-	GTSClient        GTSClient         `json:"gtsClient"`
-	Server           Server            `json:"server"`
-	Integrations     Integrations      `json:"integrations"`
+	populated         bool
+	Path              string            `json:"-"`
+	Aliases           map[string]string `json:"aliases"`
+	CredentialsFile   string            `json:"credentialsFile"`
+	CacheDirectory    string            `json:"cacheDirectory"`
+	MediaCacheMaxSize int               `json:"mediaCacheMaxSize"`
+	LineWrapMaxWidth  int               `json:"lineWrapMaxWidth"`
+	GTSClient         GTSClient         `json:"gtsClient"`
+	Server            Server            `json:"server"`
+	Integrations      Integrations      `json:"integrations"`
 }
 
 func NewConfigFromFile(configFilepath string) (Config, error) {
@@ -36,13 +42,25 @@ func (c Config) IsZero() bool {
 }
 
 type GTSClient struct {
-	Timeout      int `json:"timeout"`
-	MediaTimeout int `json:"mediaTimeout"`
+	Timeout        int      `json:"timeout"`
+	MediaTimeout   int      `json:"mediaTimeout"`
+	CacheResponses bool     `json:"cacheResponses"`
+	CacheTTL       CacheTTL `json:"cacheTTL"`
+}
+
+// CacheTTL is the time (in seconds) that the cached responses of each resource type
+// are used without revalidating them with the instance.
+type CacheTTL struct {
+	Accounts int `json:"accounts"`
+	Instance int `json:"instance"`
+	Lists    int `json:"lists"`
+	Default  int `json:"default"`
 }
 
 type Server struct {
-	SocketPath  string `json:"socketPath"`
-	IdleTimeout int    `json:"idleTimeout"`
+	SocketPath       string `json:"socketPath"`
+	IdleTimeout      int    `json:"idleTimeout"`
+	NotifierInterval int    `json:"notifierInterval"`
 }
 
 type Integrations struct {
@@ -52,6 +70,7 @@ type Integrations struct {
 	ImageViewer string `json:"imageViewer"`
 	VideoPlayer string `json:"videoPlayer"`
 	AudioPlayer string `json:"audioPlayer"`
+	Notifier    string `json:"notifier"`
 }
 
 func newConfigFromFile(configFilepath string) (Config, error) {
@@ -66,7 +85,9 @@ func newConfigFromFile(configFilepath string) (Config, error) {
 	}
 	defer file.Close()
 
-	var cfg Config
+	// The configuration is decoded on top of the initial configuration so that
+	// the settings missing from older configuration files get their default values.
+	cfg := initialConfig()
 
 	if err := json.NewDecoder(file).Decode(&cfg); err != nil {
 		return Config{}, fmt.Errorf("error decoding the JSON data: %w", err)
@@ -167,16 +188,25 @@ func saveConfig(configFilepath string, cfg Config) error {
 
 func initialConfig() Config {
 	return Config{
-		CredentialsFile: "",
-		CacheDirectory:  "",
-		Aliases:         make(map[string]string),
+		CredentialsFile:   "",
+		CacheDirectory:    "",
+		MediaCacheMaxSize: 0,
+		Aliases:           make(map[string]string),
 		GTSClient: GTSClient{
-			Timeout:      defaultHTTPTimeout,
-			MediaTimeout: defaultHTTPMediaTimeout,
+			Timeout:        defaultHTTPTimeout,
+			MediaTimeout:   defaultHTTPMediaTimeout,
+			CacheResponses: false,
+			CacheTTL: CacheTTL{
+				Accounts: defaultCacheTTLAccounts,
+				Instance: defaultCacheTTLInstance,
+				Lists:    defaultCacheTTLLists,
+				Default:  defaultCacheTTL,
+			},
 		},
 		Server: Server{
-			SocketPath:  "",
-			IdleTimeout: defaultServerIdleTimeout,
+			SocketPath:       "",
+			IdleTimeout:      defaultServerIdleTimeout,
+			NotifierInterval: defaultNotifierInterval,
 		},
 		LineWrapMaxWidth: defaultLineWrapMaxWidth,
 		Integrations: Integrations{
@@ -186,6 +216,7 @@ func initialConfig() Config {
 			ImageViewer: "",
 			VideoPlayer: "",
 			AudioPlayer: "",
+			Notifier:    "",
 		},
 	}
 }
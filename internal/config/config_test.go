@@ -65,3 +65,52 @@ func testLoadConfigFromFile(configFilepath string) func(t *testing.T) {
 		}
 	}
 }
+
+func TestLoadConfigWithMissingSettings(t *testing.T) {
+	configFilepath := filepath.Join(t.TempDir(), "config.json")
+
+	data := `{
+    "credentialsFile": "/home/alice/.local/share/enbas/credentials.json",
+    "gtsClient": {
+        "timeout": 10,
+        "cacheResponses": true
+    },
+    "server": {
+        "socketPath": "/run/user/1000/enbas.sock"
+    }
+}`
+
+	if err := os.WriteFile(configFilepath, []byte(data), 0o600); err != nil {
+		t.Fatalf("Unable to write the configuration to %q: %v", configFilepath, err)
+	}
+
+	cfg, err := config.NewConfigFromFile(configFilepath)
+	if err != nil {
+		t.Fatalf("Unable to load the configuration from file: %v", err)
+	}
+
+	testCases := []struct {
+		name string
+		want int
+		got  int
+	}{
+		{name: "gtsClient.timeout", want: 10, got: cfg.GTSClient.Timeout},
+		{name: "gtsClient.mediaTimeout", want: 30, got: cfg.GTSClient.MediaTimeout},
+		{name: "gtsClient.cacheTTL.accounts", want: 60, got: cfg.GTSClient.CacheTTL.Accounts},
+		{name: "gtsClient.cacheTTL.instance", want: 3600, got: cfg.GTSClient.CacheTTL.Instance},
+		{name: "gtsClient.cacheTTL.lists", want: 300, got: cfg.GTSClient.CacheTTL.Lists},
+		{name: "server.idleTimeout", want: 300, got: cfg.Server.IdleTimeout},
+		{name: "server.notifierInterval", want: 60, got: cfg.Server.NotifierInterval},
+		{name: "lineWrapMaxWidth", want: 80, got: cfg.LineWrapMaxWidth},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Unexpected value for %s: want %d, got %d", tc.name, tc.want, tc.got)
+		}
+	}
+
+	if !cfg.GTSClient.CacheResponses {
+		t.Error("The response cache was not enabled from the configuration file")
+	}
+}
@@ -0,0 +1,153 @@
+package drafts
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+const fileExtension string = ".json"
+
+// NewID returns a new ID for a draft created at the specified time.
+// The IDs of the drafts are sorted by the time that they were created.
+func NewID(createdAt time.Time) (string, error) {
+	suffix := make([]byte, 3)
+
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("unable to generate the random suffix: %w", err)
+	}
+
+	return createdAt.UTC().Format("20060102150405") + "-" + hex.EncodeToString(suffix), nil
+}
+
+// Save saves the draft to the drafts directory. If the directory is not present
+// it will be created.
+func Save(dir string, draft model.Draft) error {
+	path, err := draftPath(dir, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := utilities.EnsureDirectory(dir); err != nil {
+		return fmt.Errorf("unable to ensure the existence of the drafts directory: %w", err)
+	}
+
+	file, err := utilities.CreateFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to create the file at %s: %w", path, err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "    ")
+
+	if err := encoder.Encode(draft); err != nil {
+		return fmt.Errorf("unable to save the JSON data to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Load loads the draft with the specified ID from the drafts directory.
+func Load(dir, draftID string) (model.Draft, error) {
+	path, err := draftPath(dir, draftID)
+	if err != nil {
+		return model.Draft{}, err
+	}
+
+	exists, err := utilities.FileExists(path)
+	if err != nil {
+		return model.Draft{}, fmt.Errorf("unable to check for the draft: %w", err)
+	}
+
+	if !exists {
+		return model.Draft{}, DraftNotFoundError{draftID: draftID}
+	}
+
+	return loadFile(path)
+}
+
+// List returns all the drafts in the drafts directory, starting with the oldest draft.
+func List(dir string) ([]model.Draft, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []model.Draft{}, nil
+		}
+
+		return nil, fmt.Errorf("unable to read the drafts directory: %w", err)
+	}
+
+	drafts := make([]model.Draft, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), fileExtension) {
+			continue
+		}
+
+		draft, err := loadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		drafts = append(drafts, draft)
+	}
+
+	slices.SortFunc(drafts, func(a, b model.Draft) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return drafts, nil
+}
+
+// Delete deletes the draft with the specified ID from the drafts directory.
+func Delete(dir, draftID string) error {
+	path, err := draftPath(dir, draftID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return DraftNotFoundError{draftID: draftID}
+		}
+
+		return fmt.Errorf("unable to delete %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func loadFile(path string) (model.Draft, error) {
+	file, err := utilities.OpenFile(path)
+	if err != nil {
+		return model.Draft{}, fmt.Errorf("unable to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var draft model.Draft
+
+	if err := json.NewDecoder(file).Decode(&draft); err != nil {
+		return model.Draft{}, fmt.Errorf("unable to decode the JSON data from %s: %w", path, err)
+	}
+
+	return draft, nil
+}
+
+func draftPath(dir, draftID string) (string, error) {
+	if draftID == "" || draftID != filepath.Base(draftID) || strings.HasPrefix(draftID, ".") {
+		return "", InvalidDraftIDError{draftID: draftID}
+	}
+
+	return filepath.Join(dir, draftID+fileExtension), nil
+}
@@ -0,0 +1,124 @@
+package drafts_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/drafts"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+func TestSaveLoadAndDelete(t *testing.T) {
+	dir := t.TempDir() + "/drafts"
+	sensitive := true
+
+	draft := model.Draft{
+		ID:        "20261018083000-a1b2c3",
+		CreatedAt: time.Date(2026, time.October, 18, 8, 30, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2026, time.October, 18, 8, 30, 0, 0, time.UTC),
+		Status: model.DraftStatus{
+			AddPoll:                  false,
+			AttachmentIDs:            nil,
+			CanFavouriteAlways:       nil,
+			CanFavouriteWithApproval: nil,
+			CanReblogAlways:          nil,
+			CanReblogWithApproval:    nil,
+			CanReplyAlways:           []string{"followers", "mentioned"},
+			CanReplyWithApproval:     []string{"public"},
+			Content:                  "Hello, world!",
+			ContentType:              "plain",
+			InReplyTo:                "",
+			Language:                 "en",
+			LocalOnly:                false,
+			MediaFiles: []model.DraftMediaFile{
+				{Path: "/tmp/cat.png", Description: "A photo of a cat", Focus: "0.5,0.5"},
+			},
+			NotBoostable:              false,
+			NotLikeable:               false,
+			NotReplyable:              false,
+			PollAllowsMultipleChoices: false,
+			PollExpiresIn:             86400,
+			PollHidesVoteCounts:       false,
+			PollOptions:               nil,
+			ScheduledAt:               time.Time{},
+			Sensitive:                 &sensitive,
+			Summary:                   "",
+			Visibility:                "public",
+		},
+	}
+
+	if err := drafts.Save(dir, draft); err != nil {
+		t.Fatalf("Unable to save the draft: %v", err)
+	}
+
+	loaded, err := drafts.Load(dir, draft.ID)
+	if err != nil {
+		t.Fatalf("Unable to load the draft: %v", err)
+	}
+
+	if loaded.Status.Content != draft.Status.Content {
+		t.Errorf("Unexpected content received: want %q, got %q", draft.Status.Content, loaded.Status.Content)
+	}
+
+	if len(loaded.Status.MediaFiles) != 1 || loaded.Status.MediaFiles[0] != draft.Status.MediaFiles[0] {
+		t.Errorf("Unexpected media files received: want %v, got %v", draft.Status.MediaFiles, loaded.Status.MediaFiles)
+	}
+
+	if !slices.Equal(loaded.Status.CanReplyAlways, draft.Status.CanReplyAlways) {
+		t.Errorf("Unexpected reply policy received: want %v, got %v", draft.Status.CanReplyAlways, loaded.Status.CanReplyAlways)
+	}
+
+	if loaded.Status.Sensitive == nil || !*loaded.Status.Sensitive {
+		t.Error("Unexpected sensitive value received: want true")
+	}
+
+	if err := drafts.Delete(dir, draft.ID); err != nil {
+		t.Fatalf("Unable to delete the draft: %v", err)
+	}
+
+	_, err = drafts.Load(dir, draft.ID)
+
+	var notFoundErr drafts.DraftNotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("Unexpected error received after loading the deleted draft: got %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, draftID := range []string{"20261018090000-bbbbbb", "20261017090000-aaaaaa"} {
+		if err := drafts.Save(dir, model.Draft{ID: draftID}); err != nil {
+			t.Fatalf("Unable to save the draft: %v", err)
+		}
+	}
+
+	list, err := drafts.List(dir)
+	if err != nil {
+		t.Fatalf("Unable to list the drafts: %v", err)
+	}
+
+	got := make([]string, len(list))
+	for idx := range list {
+		got[idx] = list[idx].ID
+	}
+
+	if want := []string{"20261017090000-aaaaaa", "20261018090000-bbbbbb"}; !slices.Equal(want, got) {
+		t.Errorf("Unexpected list of drafts received: want %v, got %v", want, got)
+	}
+}
+
+func TestInvalidDraftID(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, draftID := range []string{"", "../credentials", ".hidden"} {
+		_, err := drafts.Load(dir, draftID)
+
+		var invalidErr drafts.InvalidDraftIDError
+		if !errors.As(err, &invalidErr) {
+			t.Errorf("Unexpected error received for the draft ID %q: got %v", draftID, err)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package drafts
+
+type DraftNotFoundError struct {
+	draftID string
+}
+
+func (e DraftNotFoundError) Error() string {
+	return "unable to find the draft with ID '" + e.draftID + "'"
+}
+
+type InvalidDraftIDError struct {
+	draftID string
+}
+
+func (e InvalidDraftIDError) Error() string {
+	return "'" + e.draftID + "' is not a valid draft ID"
+}
@@ -3,6 +3,9 @@ package executor
 import (
 	"fmt"
 	"net/rpc"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
@@ -37,6 +40,8 @@ func accountFunc(
 	switch cmd.Action {
 	case cli.ActionShow:
 		return accountShow(session.Client(), printSettings, cfg.Integrations.Browser, cmd.FocusedTargetFlags)
+	case cli.ActionEdit:
+		return accountEdit(session.Client(), printSettings, cfg.Integrations.Editor, cmd.FocusedTargetFlags)
 	case cli.ActionMute:
 		return accountMute(session.Client(), printSettings, cmd.FocusedTargetFlags)
 	case cli.ActionUnmute:
@@ -156,8 +161,14 @@ func accountShow(
 
 	if showStatuses {
 		args := gtsclient.GetAccountStatusesArgs{
-			AccountID:      account.ID,
-			Limit:          maxStatuses,
+			AccountID: account.ID,
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   maxStatuses,
+				MaxID:   "",
+				MinID:   "",
+				SinceID: "",
+				All:     false,
+			},
 			ExcludeReplies: excludeReplies,
 			ExcludeReblogs: excludeReblogs,
 			OnlyMedia:      onlyMedia,
@@ -168,6 +179,9 @@ func accountShow(
 		if err := client.Call("GTSClient.GetAccountStatuses", args, &statusList); err != nil {
 			return fmt.Errorf("unable to retrieve the account's statuses: %w", err)
 		}
+
+		// The account view does not support paging through the statuses.
+		statusList.Pagination = model.Pagination{}
 	}
 
 	if err := printer.PrintAccount(
@@ -184,6 +198,220 @@ func accountShow(
 	return nil
 }
 
+func accountEdit(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	editor string,
+	flags []string,
+) error {
+	var (
+		myAccount          bool
+		displayName        string
+		bio                string
+		editBio            bool
+		profileFields      = internalFlag.NewMultiStringValue()
+		avatarFile         string
+		avatarDescription  string
+		headerFile         string
+		headerDescription  string
+		locked             internalFlag.BoolValue
+		bot                internalFlag.BoolValue
+		discoverable       internalFlag.BoolValue
+		defaultVisibility  internalFlag.EnumValue
+		defaultLanguage    string
+		defaultContentType internalFlag.EnumValue
+		theme              string
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseAccountEditFlags(
+		&myAccount,
+		&displayName,
+		&bio,
+		&editBio,
+		&profileFields,
+		&avatarFile,
+		&avatarDescription,
+		&headerFile,
+		&headerDescription,
+		&locked,
+		&bot,
+		&discoverable,
+		&defaultVisibility,
+		&defaultLanguage,
+		&defaultContentType,
+		&theme,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if !myAccount {
+		return editOtherAccountError{}
+	}
+
+	form := gtsclient.UpdateCredentialsForm{
+		DisplayName:       displayName,
+		Note:              "",
+		ClearNote:         false,
+		Fields:            nil,
+		AvatarPath:        avatarFile,
+		AvatarDescription: avatarDescription,
+		HeaderPath:        headerFile,
+		HeaderDescription: headerDescription,
+		Locked:            "",
+		Bot:               "",
+		Discoverable:      "",
+		Privacy:           defaultVisibility.Value(),
+		Language:          defaultLanguage,
+		StatusContentType: "",
+		Theme:             theme,
+	}
+
+	if bio != "" {
+		note, err := utilities.ReadContents(bio)
+		if err != nil {
+			return fmt.Errorf("unable to read the biography: %w", err)
+		}
+
+		form.Note = note
+	}
+
+	if editBio {
+		note, changed, err := accountEditBio(client, editor, form.Note)
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			form.Note = note
+			form.ClearNote = note == ""
+		}
+	}
+
+	if len(profileFields.Values()) > 0 {
+		fields, err := accountEditFields(client, profileFields.Values())
+		if err != nil {
+			return err
+		}
+
+		form.Fields = fields
+	}
+
+	if locked.IsSet() {
+		form.Locked = strconv.FormatBool(locked.Value())
+	}
+
+	if bot.IsSet() {
+		form.Bot = strconv.FormatBool(bot.Value())
+	}
+
+	if discoverable.IsSet() {
+		form.Discoverable = strconv.FormatBool(discoverable.Value())
+	}
+
+	if defaultContentType.Value() != "" {
+		form.StatusContentType = "text/" + defaultContentType.Value()
+	}
+
+	if form.IsZero() {
+		return zeroValuesError{
+			valueType: "account setting",
+			action:    "update",
+		}
+	}
+
+	var account model.Account
+	if err := client.Call(
+		"GTSClient.UpdateCredentials",
+		form,
+		&account,
+	); err != nil {
+		return fmt.Errorf("error updating your account: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully updated your account.")
+
+	return nil
+}
+
+// accountEditBio opens the user's text editor for editing the biography of their account.
+// The editor opens with the specified biography or the current biography if none is
+// specified. False is returned if the biography was not changed.
+func accountEditBio(client *rpc.Client, editor, bio string) (string, bool, error) {
+	original := bio
+
+	if original == "" {
+		var account model.Account
+		if err := client.Call(
+			"GTSClient.GetMyAccount",
+			gtsclient.NoRPCArgs{},
+			&account,
+		); err != nil {
+			return "", false, fmt.Errorf("unable to retrieve your account: %w", err)
+		}
+
+		original = account.Source.Note
+	}
+
+	edited, err := utilities.EditText(editor, original)
+	if err != nil {
+		return "", false, fmt.Errorf("unable to edit your biography: %w", err)
+	}
+
+	edited = strings.TrimSpace(edited)
+
+	return edited, edited != strings.TrimSpace(original) || bio != "", nil
+}
+
+// accountEditFields merges the specified profile fields with the existing profile
+// fields of your account. GoToSocial replaces the whole set of profile fields
+// so the existing fields are retrieved to avoid deleting them.
+func accountEditFields(client *rpc.Client, values []string) ([]model.Field, error) {
+	var account model.Account
+	if err := client.Call(
+		"GTSClient.GetMyAccount",
+		gtsclient.NoRPCArgs{},
+		&account,
+	); err != nil {
+		return nil, fmt.Errorf("unable to retrieve your account: %w", err)
+	}
+
+	fields := make([]model.Field, len(account.Source.Fields))
+	for idx := range account.Source.Fields {
+		fields[idx] = model.Field{
+			Name:       account.Source.Fields[idx].Name,
+			Value:      account.Source.Fields[idx].Value,
+			VerifiedAt: time.Time{},
+		}
+	}
+
+	for _, value := range values {
+		name, fieldValue, found := strings.Cut(value, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			return nil, invalidProfileFieldError{field: value}
+		}
+
+		field := model.Field{
+			Name:       strings.TrimSpace(name),
+			Value:      strings.TrimSpace(fieldValue),
+			VerifiedAt: time.Time{},
+		}
+
+		idx := slices.IndexFunc(fields, func(existing model.Field) bool {
+			return existing.Name == field.Name
+		})
+
+		if idx == -1 {
+			fields = append(fields, field)
+		} else {
+			fields[idx] = field
+		}
+	}
+
+	return fields, nil
+}
+
 func accountMute(
 	client *rpc.Client,
 	printSettings printer.Settings,
@@ -193,6 +421,7 @@ func accountMute(
 		accountName       string
 		duration          = internalFlag.NewTimeDurationValue(time.Duration(0))
 		muteNotifications bool
+		fromStdin         bool
 	)
 
 	// Parse the remaining flags
@@ -200,11 +429,24 @@ func accountMute(
 		&accountName,
 		&duration,
 		&muteNotifications,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionMute,
+			target: cli.TargetAccount,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountMute(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if accountName == "" {
 		return missingValueError{
 			valueType: "name",
@@ -244,6 +486,7 @@ func accountFollow(
 		accountName string
 		notify      bool
 		showReblogs bool
+		fromStdin   bool
 	)
 
 	// Parse the remaining flags
@@ -251,11 +494,24 @@ func accountFollow(
 		&accountName,
 		&notify,
 		&showReblogs,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionFollow,
+			target: cli.TargetAccount,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountFollow(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if accountName == "" {
 		return missingValueError{
 			valueType: "name",
@@ -291,16 +547,32 @@ func accountUnfollow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var accountName string
+	var (
+		accountName string
+		fromStdin   bool
+	)
 
 	// Parse the remaining flags
 	if err := cli.ParseAccountUnfollowFlags(
 		&accountName,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionUnfollow,
+			target: cli.TargetAccount,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountUnfollow(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if accountName == "" {
 		return missingValueError{
 			valueType: "name",
@@ -328,16 +600,32 @@ func accountUnmute(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var accountName string
+	var (
+		accountName string
+		fromStdin   bool
+	)
 
 	// Parse the remaining flags
 	if err := cli.ParseAccountUnmuteFlags(
 		&accountName,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionUnmute,
+			target: cli.TargetAccount,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountUnmute(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if accountName == "" {
 		return missingValueError{
 			valueType: "name",
@@ -365,16 +653,32 @@ func accountBlock(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var accountName string
+	var (
+		accountName string
+		fromStdin   bool
+	)
 
 	// Parse the remaining flags
 	if err := cli.ParseAccountBlockFlags(
 		&accountName,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionBlock,
+			target: cli.TargetAccount,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountBlock(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if accountName == "" {
 		return missingValueError{
 			valueType: "name",
@@ -410,16 +714,32 @@ func accountUnblock(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var accountName string
+	var (
+		accountName string
+		fromStdin   bool
+	)
 
 	// Parse the remaining flags
 	if err := cli.ParseAccountUnblockFlags(
 		&accountName,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionUnblock,
+			target: cli.TargetAccount,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountUnblock(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if accountName == "" {
 		return missingValueError{
 			valueType: "name",
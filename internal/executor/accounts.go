@@ -83,17 +83,31 @@ func accountsAddToList(
 	var (
 		listID       string
 		accountNames = internalFlag.NewMultiStringValue()
+		fromStdin    bool
 	)
 
 	// Parse the remaining flags
 	if err := cli.ParseAccountsAddToListFlags(
 		&listID,
 		&accountNames,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err //nolint:wrapcheck
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionAdd,
+			target: cli.TargetAccounts,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountsAddToList(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if listID == "" {
 		return missingIDError{
 			target: cli.TargetList,
@@ -183,17 +197,31 @@ func accountsRemoveFromList(
 	var (
 		listID       string
 		accountNames = internalFlag.NewMultiStringValue()
+		fromStdin    bool
 	)
 
 	// Parse the remaining flags.
 	if err := cli.ParseAccountsRemoveFromListFlags(
 		&listID,
 		&accountNames,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err //nolint:wrapcheck
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionRemove,
+			target: cli.TargetAccounts,
+			flag:   "account-name",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return accountsRemoveFromList(client, itemSettings, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if listID == "" {
 		return missingIDError{
 			target: cli.TargetList,
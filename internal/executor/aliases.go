@@ -35,7 +35,7 @@ func aliasesShow(
 	aliases map[string]string,
 	printSettings printer.Settings,
 ) error {
-	if len(aliases) > 0 {
+	if len(aliases) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintAliases(printSettings, aliases); err != nil {
 			return fmt.Errorf("error printing the list of aliases: %w", err)
 		}
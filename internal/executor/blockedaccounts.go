@@ -2,11 +2,15 @@ package executor
 
 import (
 	"fmt"
+	"io"
 	"net/rpc"
+	"strconv"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/mastodoncsv"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
@@ -33,6 +37,15 @@ func blockedAccountsFunc(
 	switch cmd.Action {
 	case cli.ActionShow:
 		return blockedAccountsShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionExport:
+		return blockedAccountsExport(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionImport:
+		return blockedAccountsImport(
+			session.Client(),
+			printSettings,
+			cfg.CacheDirectory,
+			cmd.FocusedTargetFlags,
+		)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetBlockedAccounts}
 	}
@@ -43,11 +56,19 @@ func blockedAccountsShow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var limit int
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
 
 	// Parse the remaining flags.
 	if err := cli.ParseBlockedAccountsShowFlags(
 		&limit,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
@@ -56,13 +77,19 @@ func blockedAccountsShow(
 	var blocked model.AccountList
 	if err := client.Call(
 		"GTSClient.GetBlockedAccounts",
-		limit,
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
 		&blocked,
 	); err != nil {
 		return fmt.Errorf("error retrieving the list of blocked accounts: %w", err)
 	}
 
-	if len(blocked.Accounts) > 0 {
+	if len(blocked.Accounts) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintAccountList(printSettings, blocked); err != nil {
 			return fmt.Errorf("error printing the list of blocked accounts: %w", err)
 		}
@@ -72,3 +99,122 @@ func blockedAccountsShow(
 
 	return nil
 }
+
+// blockedAccountsExport saves the accounts that you have blocked to a CSV file
+// in the format of Mastodon's blocked_accounts.csv file.
+func blockedAccountsExport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var file string
+
+	// Parse the remaining flags.
+	if err := cli.ParseBlockedAccountsExportFlags(
+		&file,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var blocked model.AccountList
+	if err := client.Call(
+		"GTSClient.GetBlockedAccounts",
+		gtsclient.PaginationArgs{
+			Limit:   80,
+			MaxID:   "",
+			MinID:   "",
+			SinceID: "",
+			All:     true,
+		},
+		&blocked,
+	); err != nil {
+		return fmt.Errorf("error retrieving the list of blocked accounts: %w", err)
+	}
+
+	accountAddress, err := accountAddressFunc(client)
+	if err != nil {
+		return err
+	}
+
+	records := make([]mastodoncsv.Blocked, len(blocked.Accounts))
+
+	for idx, account := range blocked.Accounts {
+		records[idx] = mastodoncsv.Blocked{
+			Line:    idx + 1,
+			Account: accountAddress(account),
+		}
+	}
+
+	if err := writeCSVFile(file, func(writer io.Writer) error {
+		return mastodoncsv.WriteBlocked(writer, records)
+	}); err != nil {
+		return err
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"Successfully exported "+strconv.Itoa(len(records))+" blocked accounts to "+file+".",
+	)
+
+	return nil
+}
+
+// blockedAccountsImport blocks the accounts from a CSV file in the format
+// of Mastodon's blocked_accounts.csv file.
+func blockedAccountsImport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	cacheRoot string,
+	flags []string,
+) error {
+	var (
+		file   string
+		dryRun bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseBlockedAccountsImportFlags(
+		&file,
+		&dryRun,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	data, err := readCSVFile(file)
+	if err != nil {
+		return err
+	}
+
+	readRecords := func(reader io.Reader) ([]csvImportRecord, error) {
+		blocked, err := mastodoncsv.ReadBlocked(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]csvImportRecord, len(blocked))
+
+		for idx := range blocked {
+			records[idx] = csvImportRecord{
+				line:        blocked[idx].Line,
+				account:     blocked[idx].Account,
+				description: "block " + blocked[idx].Account,
+				apply: func(accountID string) error {
+					return client.Call("GTSClient.BlockAccount", accountID, nil)
+				},
+			}
+		}
+
+		return records, nil
+	}
+
+	return csvImport{
+		name:        "IMPORT BLOCKED ACCOUNTS",
+		target:      cli.TargetBlockedAccounts,
+		data:        data,
+		cacheRoot:   cacheRoot,
+		dryRun:      dryRun,
+		readRecords: readRecords,
+	}.run(client, printSettings)
+}
@@ -48,22 +48,40 @@ func bookmarksShow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var limit int
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
 
 	// Parse the remaining flags.
 	if err := cli.ParseBookmarksShowFlags(
 		&limit,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
 	}
 
 	var bookmarks model.StatusList
-	if err := client.Call("GTSClient.GetBookmarks", limit, &bookmarks); err != nil {
+	if err := client.Call(
+		"GTSClient.GetBookmarks",
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
+		&bookmarks,
+	); err != nil {
 		return fmt.Errorf("error retrieving the list of your bookmarks: %w", err)
 	}
 
-	if len(bookmarks.Statuses) == 0 {
+	if len(bookmarks.Statuses) == 0 && printSettings.TextOutput() {
 		printer.PrintInfo("You have no bookmarks.\n")
 
 		return nil
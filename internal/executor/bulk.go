@@ -0,0 +1,149 @@
+package executor
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+)
+
+// bulkConcurrency is the maximum number of items that are processed
+// at the same time over the RPC session.
+const bulkConcurrency int = 4
+
+// bulkOperation runs an action on each ID or account name read from standard input.
+// The action is run through the same executor function as a single item with the
+// item passed to the function using the specified flag.
+type bulkOperation struct {
+	action string
+	target string
+
+	// flag is the name of the flag that each item is passed to.
+	flag string
+
+	// flags are the flags of the original command.
+	flags []string
+
+	// run runs the action with the specified flags.
+	run func(printSettings printer.Settings, flags []string) error
+}
+
+// execute reads the items from standard input and runs the action on each item
+// concurrently. The success messages of the individual items are replaced by
+// a summary of the results.
+func (b bulkOperation) execute(printSettings printer.Settings) error {
+	items, err := readItems(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("unable to read the items from standard input: %w", err)
+	}
+
+	if len(items) == 0 {
+		return noItemsFromStdinError{}
+	}
+
+	// Remove the flag for reading from standard input and any values
+	// of the item flag from the original command.
+	baseFlags := removeFlag(b.flags, "from-stdin", false)
+	baseFlags = removeFlag(baseFlags, b.flag, true)
+
+	var (
+		results   = make([]model.BulkResult, len(items))
+		semaphore = make(chan struct{}, bulkConcurrency)
+		waitGroup sync.WaitGroup
+	)
+
+	for idx, item := range items {
+		waitGroup.Add(1)
+
+		semaphore <- struct{}{}
+
+		go func() {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+
+			itemFlags := append(baseFlags[:len(baseFlags):len(baseFlags)], "--"+b.flag+"="+item)
+
+			result := model.BulkResult{
+				Item:      item,
+				Succeeded: true,
+				Error:     "",
+			}
+
+			if err := b.run(printSettings.Quiet(), itemFlags); err != nil {
+				result.Succeeded = false
+				result.Error = err.Error()
+			}
+
+			results[idx] = result
+		}()
+	}
+
+	waitGroup.Wait()
+
+	report := model.BulkReport{
+		Action:  b.action,
+		Target:  b.target,
+		Results: results,
+	}
+
+	if err := printer.PrintBulkReport(printSettings, report); err != nil {
+		return fmt.Errorf("error printing the results: %w", err)
+	}
+
+	if failed := report.Failed(); failed > 0 {
+		return bulkOperationFailedError{failed: failed, total: len(results)}
+	}
+
+	return nil
+}
+
+// readItems reads the newline-separated items. Empty lines and lines
+// starting with '#' are ignored.
+func readItems(reader io.Reader) ([]string, error) {
+	var items []string
+
+	scanner := bufio.NewScanner(reader)
+
+	for scanner.Scan() {
+		item := strings.TrimSpace(scanner.Text())
+		if item == "" || strings.HasPrefix(item, "#") {
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return items, nil
+}
+
+// removeFlag removes all occurrences of the named flag from the command line flags.
+// If the flag takes a value then the value is also removed when it is specified
+// as a separate argument.
+func removeFlag(flags []string, name string, hasValue bool) []string {
+	output := make([]string, 0, len(flags))
+
+	for idx := 0; idx < len(flags); idx++ {
+		trimmed := strings.TrimLeft(flags[idx], "-")
+
+		if !strings.HasPrefix(flags[idx], "-") || (trimmed != name && !strings.HasPrefix(trimmed, name+"=")) {
+			output = append(output, flags[idx])
+
+			continue
+		}
+
+		if hasValue && trimmed == name {
+			idx++
+		}
+	}
+
+	return output
+}
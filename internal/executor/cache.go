@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+	"strconv"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+func cacheFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return cacheShow(session.Client(), printSettings)
+	case cli.ActionClear:
+		return cacheClear(session.Client(), printSettings)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetCache}
+	}
+}
+
+func cacheShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+) error {
+	var cache model.ResponseCache
+	if err := client.Call("GTSClient.GetResponseCache", gtsclient.NoRPCArgs{}, &cache); err != nil {
+		return fmt.Errorf("unable to retrieve the cached responses: %w", err)
+	}
+
+	if len(cache.Entries) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("There are no cached responses for your account.\n")
+
+		return nil
+	}
+
+	if err := printer.PrintResponseCache(printSettings, cache); err != nil {
+		return fmt.Errorf("error printing the cached responses: %w", err)
+	}
+
+	return nil
+}
+
+func cacheClear(
+	client *rpc.Client,
+	printSettings printer.Settings,
+) error {
+	var removed int
+	if err := client.Call("GTSClient.ClearResponseCache", gtsclient.NoRPCArgs{}, &removed); err != nil {
+		return fmt.Errorf("unable to clear the cached responses: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "You have successfully removed "+strconv.Itoa(removed)+" cached responses.")
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+	"strings"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+// conversationFunc is the function for the conversation target for managing
+// and replying to a private conversation.
+func conversationFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionRead:
+		return conversationRead(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionDelete:
+		return conversationDelete(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionReply:
+		return conversationReply(
+			session.Client(),
+			printSettings,
+			cfg.Integrations.Editor,
+			cmd.FocusedTargetFlags,
+		)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetConversation}
+	}
+}
+
+func conversationRead(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var conversationID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseConversationReadFlags(
+		&conversationID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if conversationID == "" {
+		return missingIDError{
+			target: cli.TargetConversation,
+			action: cli.ActionRead,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.MarkConversationAsRead",
+		conversationID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error marking the conversation as read: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully marked the conversation as read.")
+
+	return nil
+}
+
+func conversationDelete(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var conversationID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseConversationDeleteFlags(
+		&conversationID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if conversationID == "" {
+		return missingIDError{
+			target: cli.TargetConversation,
+			action: cli.ActionDelete,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.DeleteConversation",
+		conversationID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error deleting the conversation: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully deleted the conversation.")
+
+	return nil
+}
+
+func conversationReply(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	editor string,
+	flags []string,
+) error {
+	var (
+		conversationID string
+		content        string
+		contentType    internalFlag.EnumValue
+		sensitive      internalFlag.BoolValue
+		summary        string
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseConversationReplyFlags(
+		&conversationID,
+		&content,
+		&contentType,
+		&sensitive,
+		&summary,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if conversationID == "" {
+		return missingIDError{
+			target: cli.TargetConversation,
+			action: cli.ActionReply,
+		}
+	}
+
+	var conversation model.Conversation
+	if err := client.Call(
+		"GTSClient.GetConversation",
+		conversationID,
+		&conversation,
+	); err != nil {
+		return fmt.Errorf("error retrieving the conversation: %w", err)
+	}
+
+	// Mention all the participants at the beginning of the reply.
+	mentions := make([]string, len(conversation.Accounts))
+	for idx := range conversation.Accounts {
+		mentions[idx] = "@" + conversation.Accounts[idx].Acct
+	}
+
+	prefix := strings.Join(mentions, " ")
+
+	if content == "" {
+		// Write the reply in the user's text editor with the mentions
+		// already in place.
+		edited, err := utilities.EditText(editor, prefix+" ")
+		if err != nil {
+			return fmt.Errorf("unable to compose the reply: %w", err)
+		}
+
+		content = strings.TrimSpace(edited)
+		if content == "" || content == prefix {
+			return emptyReplyError{}
+		}
+	} else {
+		body, err := utilities.ReadContents(content)
+		if err != nil {
+			return fmt.Errorf("unable to read the content for the reply: %w", err)
+		}
+
+		content = strings.TrimSpace(prefix + " " + body)
+	}
+
+	var preferences model.Preferences
+	if err := client.Call(
+		"GTSClient.GetUserPreferences",
+		gtsclient.NoRPCArgs{},
+		&preferences,
+	); err != nil {
+		printer.PrintInfo("WARNING: Unable to get your posting preferences: " + err.Error() + ".\n")
+	}
+
+	isSensitive := preferences.PostingDefaultSensitive
+	if sensitive.IsSet() {
+		isSensitive = sensitive.Value()
+	}
+
+	inReplyTo := ""
+	if conversation.LastStatus != nil {
+		inReplyTo = conversation.LastStatus.ID
+	}
+
+	form := gtsclient.CreateStatusForm{
+		Content:           content,
+		ContentType:       "text/" + contentType.Value(),
+		Language:          preferences.PostingDefaultLanguage,
+		SpoilerText:       summary,
+		Boostable:         true,
+		LocalOnly:         false,
+		InReplyTo:         inReplyTo,
+		Likeable:          true,
+		Replyable:         true,
+		Sensitive:         isSensitive,
+		Visibility:        "direct",
+		Poll:              nil,
+		AttachmentIDs:     nil,
+		ScheduledAt:       "",
+		InteractionPolicy: nil,
+	}
+
+	var status model.Status
+	if err := client.Call(
+		"GTSClient.CreateStatus",
+		form,
+		&status,
+	); err != nil {
+		return fmt.Errorf("error creating the reply: %w", err)
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"Successfully replied to the conversation with the status with ID: "+status.ID,
+	)
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+	"slices"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// conversationsFunc is the function for the conversations target for viewing
+// your private conversations.
+func conversationsFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return conversationsShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetConversations}
+	}
+}
+
+func conversationsShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseConversationsShowFlags(
+		&limit,
+		&maxID,
+		&sinceID,
+		&all,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var list model.ConversationList
+	if err := client.Call(
+		"GTSClient.GetConversationList",
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
+		&list,
+	); err != nil {
+		return fmt.Errorf("error retrieving the list of conversations: %w", err)
+	}
+
+	if len(list.Conversations) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("You have no conversations.\n")
+
+		return nil
+	}
+
+	// List the unread conversations first while keeping the order
+	// of the conversations received from the instance.
+	slices.SortStableFunc(list.Conversations, func(a, b model.Conversation) int {
+		switch {
+		case a.Unread == b.Unread:
+			return 0
+		case a.Unread:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	var myAccountID string
+	if err := client.Call(
+		"GTSClient.GetMyAccountID",
+		gtsclient.NoRPCArgs{},
+		&myAccountID,
+	); err != nil {
+		return fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	if err := printer.PrintConversationList(printSettings, list, myAccountID); err != nil {
+		return fmt.Errorf("error printing the list of conversations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,393 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/drafts"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+// draftFunc is the function for the draft target for interacting
+// with the statuses that are saved locally as drafts.
+func draftFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	// The drafts are stored in a separate directory for each account.
+	draftsDir, err := draftsDirectory(session.Client(), cfg.CacheDirectory)
+	if err != nil {
+		return err
+	}
+
+	switch cmd.Action {
+	case cli.ActionCreate:
+		return draftCreate(
+			printSettings,
+			cfg.Integrations.Editor,
+			draftsDir,
+			cmd.FocusedTargetFlags,
+		)
+	case cli.ActionEdit:
+		return draftEdit(
+			printSettings,
+			cfg.Integrations.Editor,
+			draftsDir,
+			cmd.FocusedTargetFlags,
+		)
+	case cli.ActionShow:
+		return draftShow(
+			printSettings,
+			draftsDir,
+			cmd.FocusedTargetFlags,
+		)
+	case cli.ActionDelete:
+		return draftDelete(
+			printSettings,
+			draftsDir,
+			cmd.FocusedTargetFlags,
+		)
+	case cli.ActionPublish:
+		return draftPublish(
+			session.Client(),
+			printSettings,
+			draftsDir,
+			cmd.FocusedTargetFlags,
+		)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetDraft}
+	}
+}
+
+// draftsDirectory returns the directory where the drafts of the account
+// that you are logged into are stored.
+func draftsDirectory(client *rpc.Client, cacheRoot string) (string, error) {
+	dir, err := accountCacheDirectory(client, cacheRoot, utilities.CalculateDraftsCacheDir)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the directory for your drafts: %w", err)
+	}
+
+	return dir, nil
+}
+
+// accountCacheDirectory returns the cache directory of the account that you
+// are logged into using the specified function to calculate the directory.
+func accountCacheDirectory(
+	client *rpc.Client,
+	cacheRoot string,
+	calculateDir func(cacheRoot, instance, accountID string) (string, error),
+) (string, error) {
+	var instance string
+	if err := client.Call(
+		"GTSClient.GetInstanceURL",
+		gtsclient.NoRPCArgs{},
+		&instance,
+	); err != nil {
+		return "", fmt.Errorf("unable to get the instance URL: %w", err)
+	}
+
+	var myAccountID string
+	if err := client.Call(
+		"GTSClient.GetMyAccountID",
+		gtsclient.NoRPCArgs{},
+		&myAccountID,
+	); err != nil {
+		return "", fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	return calculateDir(cacheRoot, instance, myAccountID)
+}
+
+func draftCreate(
+	printSettings printer.Settings,
+	editor string,
+	draftsDir string,
+	flags []string,
+) error {
+	newStatus, err := parseNewStatusFlags(cli.ParseDraftCreateFlags, flags)
+	if err != nil {
+		return err
+	}
+
+	// Compose the draft in the user's text editor if there's no status body
+	// and no media attachments.
+	if newStatus.Content == "" && len(newStatus.AttachmentIDs) == 0 && len(newStatus.MediaFiles) == 0 {
+		newStatus, err = composeStatusInEditor(editor, newStatus)
+		if err != nil {
+			return err
+		}
+
+		if newStatus.Content == "" && len(newStatus.MediaFiles) == 0 {
+			printer.PrintInfo("The draft was not saved because the file was left empty.\n")
+
+			return nil
+		}
+	}
+
+	newStatus, err = selfContainedDraftStatus(newStatus)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	draftID, err := drafts.NewID(now)
+	if err != nil {
+		return fmt.Errorf("unable to create the ID for the draft: %w", err)
+	}
+
+	draft := model.Draft{
+		ID:        draftID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Status:    newStatus,
+	}
+
+	if err := drafts.Save(draftsDir, draft); err != nil {
+		return fmt.Errorf("error saving the draft: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully saved the draft with ID: "+draftID)
+
+	return nil
+}
+
+func draftEdit(
+	printSettings printer.Settings,
+	editor string,
+	draftsDir string,
+	flags []string,
+) error {
+	var draftID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseDraftEditFlags(
+		&draftID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if draftID == "" {
+		return missingIDError{
+			target: cli.TargetDraft,
+			action: cli.ActionEdit,
+		}
+	}
+
+	draft, err := drafts.Load(draftsDir, draftID)
+	if err != nil {
+		return fmt.Errorf("error loading the draft: %w", err)
+	}
+
+	edited, err := composeStatusInEditor(editor, draft.Status)
+	if err != nil {
+		return err
+	}
+
+	if edited.Content == "" && len(edited.AttachmentIDs) == 0 && len(edited.MediaFiles) == 0 {
+		printer.PrintInfo("The draft was not updated because the file was left empty.\n")
+
+		return nil
+	}
+
+	edited, err = selfContainedDraftStatus(edited)
+	if err != nil {
+		return err
+	}
+
+	draft.Status = edited
+	draft.UpdatedAt = time.Now()
+
+	if err := drafts.Save(draftsDir, draft); err != nil {
+		return fmt.Errorf("error saving the draft: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully updated the draft.")
+
+	return nil
+}
+
+// selfContainedDraftStatus reads the contents of the files referenced in the status
+// and the descriptions of its media files, and resolves the paths of the media files
+// to absolute paths. This allows the draft to be published from any directory
+// regardless of what happens to the referenced files afterwards.
+func selfContainedDraftStatus(status model.DraftStatus) (model.DraftStatus, error) {
+	content, err := utilities.ReadContents(status.Content)
+	if err != nil {
+		return model.DraftStatus{}, fmt.Errorf("unable to read the content for the draft: %w", err)
+	}
+
+	status.Content = content
+
+	mediaFiles := make([]model.DraftMediaFile, len(status.MediaFiles))
+
+	for idx, mediaFile := range status.MediaFiles {
+		path, err := utilities.AbsolutePath(mediaFile.Path)
+		if err != nil {
+			return model.DraftStatus{}, fmt.Errorf("unable to get the absolute path to %s: %w", mediaFile.Path, err)
+		}
+
+		description, err := utilities.ReadContents(mediaFile.Description)
+		if err != nil {
+			return model.DraftStatus{}, fmt.Errorf(
+				"error reading the contents from %s: %w",
+				mediaFile.Description,
+				err,
+			)
+		}
+
+		mediaFiles[idx] = model.DraftMediaFile{
+			Path:        path,
+			Description: description,
+			Focus:       mediaFile.Focus,
+		}
+	}
+
+	status.MediaFiles = mediaFiles
+
+	return status, nil
+}
+
+func draftShow(
+	printSettings printer.Settings,
+	draftsDir string,
+	flags []string,
+) error {
+	var draftID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseDraftShowFlags(
+		&draftID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if draftID != "" {
+		draft, err := drafts.Load(draftsDir, draftID)
+		if err != nil {
+			return fmt.Errorf("error loading the draft: %w", err)
+		}
+
+		if err := printer.PrintDraft(printSettings, draft); err != nil {
+			return fmt.Errorf("error printing the draft: %w", err)
+		}
+
+		return nil
+	}
+
+	allDrafts, err := drafts.List(draftsDir)
+	if err != nil {
+		return fmt.Errorf("error loading your drafts: %w", err)
+	}
+
+	if len(allDrafts) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("You have no drafts.\n")
+
+		return nil
+	}
+
+	list := model.DraftList{
+		Name:   "Drafts",
+		Drafts: allDrafts,
+	}
+
+	if err := printer.PrintDraftList(printSettings, list); err != nil {
+		return fmt.Errorf("error printing the list of your drafts: %w", err)
+	}
+
+	return nil
+}
+
+func draftDelete(
+	printSettings printer.Settings,
+	draftsDir string,
+	flags []string,
+) error {
+	var draftID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseDraftDeleteFlags(
+		&draftID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if draftID == "" {
+		return missingIDError{
+			target: cli.TargetDraft,
+			action: cli.ActionDelete,
+		}
+	}
+
+	if err := drafts.Delete(draftsDir, draftID); err != nil {
+		return fmt.Errorf("error deleting the draft: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully deleted the draft.")
+
+	return nil
+}
+
+func draftPublish(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	draftsDir string,
+	flags []string,
+) error {
+	var draftID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseDraftPublishFlags(
+		&draftID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if draftID == "" {
+		return missingIDError{
+			target: cli.TargetDraft,
+			action: cli.ActionPublish,
+		}
+	}
+
+	draft, err := drafts.Load(draftsDir, draftID)
+	if err != nil {
+		return fmt.Errorf("error loading the draft: %w", err)
+	}
+
+	if err := createStatus(client, printSettings, draft.Status); err != nil {
+		return err
+	}
+
+	if err := drafts.Delete(draftsDir, draftID); err != nil {
+		return fmt.Errorf("the status was created but there was an error deleting the draft: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully deleted the draft.")
+
+	return nil
+}
@@ -1,6 +1,10 @@
 package executor
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 type unsupportedActionError struct {
 	action string
@@ -237,3 +241,101 @@ func (e usageNoOpForTargetError) Error() string {
 		e.target +
 		"'"
 }
+
+type invalidInteractionPolicyValueError struct {
+	value string
+}
+
+func (e invalidInteractionPolicyValueError) Error() string {
+	return "'" +
+		e.value +
+		"' is not a valid value for an interaction policy: please use " +
+		strings.Join(interactionPolicyKeywords, ", ") +
+		" or the URI of an account"
+}
+
+type conflictingInteractionPolicyError struct {
+	flag   string
+	policy string
+}
+
+func (e conflictingInteractionPolicyError) Error() string {
+	return "the --" + e.flag + " flag cannot be used with the " + e.policy + " policy flags"
+}
+
+type editOtherAccountError struct{}
+
+func (e editOtherAccountError) Error() string {
+	return "only your own account can be edited: please use the --my-account flag"
+}
+
+type invalidProfileFieldError struct {
+	field string
+}
+
+func (e invalidProfileFieldError) Error() string {
+	return "'" + e.field + "' is not a valid profile field: please use the format 'name=value'"
+}
+
+type emptyReplyError struct{}
+
+func (e emptyReplyError) Error() string {
+	return "please add content to the reply"
+}
+
+type missingOutputDirectoryError struct{}
+
+func (e missingOutputDirectoryError) Error() string {
+	return "please specify the directory to save the archive to with the --output-dir flag"
+}
+
+type missingCSVFileError struct{}
+
+func (e missingCSVFileError) Error() string {
+	return "please specify the path to the CSV file with the --file flag"
+}
+
+type noItemsFromStdinError struct{}
+
+func (e noItemsFromStdinError) Error() string {
+	return "no IDs or account names were read from standard input"
+}
+
+type bulkOperationFailedError struct {
+	failed int
+	total  int
+}
+
+func (e bulkOperationFailedError) Error() string {
+	return "the action failed on " + strconv.Itoa(e.failed) + " of " + strconv.Itoa(e.total) + " items"
+}
+
+type unreadConflictError struct {
+	flag string
+}
+
+func (e unreadConflictError) Error() string {
+	return "the --unread flag cannot be used with the --" + e.flag + " flag"
+}
+
+type unreadTimelineCategoryError struct {
+	category string
+}
+
+func (e unreadTimelineCategoryError) Error() string {
+	return "the --unread flag is only supported for the home timeline, not the " + e.category + " timeline"
+}
+
+type negativeMaxSizeError struct{}
+
+func (e negativeMaxSizeError) Error() string {
+	return "the maximum size of the media cache cannot be negative"
+}
+
+type confirmationRequiredError struct {
+	action string
+}
+
+func (e confirmationRequiredError) Error() string {
+	return "standard input is not a terminal; please use the --confirm flag to " + e.action + " without being prompted"
+}
@@ -19,6 +19,7 @@ func Execute() error {
 		noColorFlag internalFlag.BoolValue
 		noColor     bool
 		configPath  string
+		output      internalFlag.EnumValue
 	)
 
 	// Initialise the print settings.
@@ -26,10 +27,11 @@ func Execute() error {
 		true,
 		"",
 		0,
+		printer.OutputFormatText,
 	)
 
 	// Parse the top level flags.
-	flagset := cli.NewTopLevelFlagset(&configPath, &noColorFlag)
+	flagset := cli.NewTopLevelFlagset(&configPath, &noColorFlag, &output)
 	if err := flagset.Parse(os.Args[1:]); err != nil {
 		printer.PrintFailure(
 			printSettings,
@@ -91,14 +93,16 @@ func Execute() error {
 			noColor,
 			cfg.Integrations.Pager,
 			cfg.LineWrapMaxWidth,
+			output.Value(),
 		)
 	} else {
 		// Otherwise update the print settings by only adjusting the
-		// 'no color' setting.
+		// 'no color' and output format settings.
 		printSettings = printer.NewSettings(
 			noColor,
 			"",
 			0,
+			output.Value(),
 		)
 	}
 
@@ -44,11 +44,19 @@ func favouritesShow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var limit int
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
 
 	// Parse the remaining flags.
 	if err := cli.ParseFavouritesShowFlags(
 		&limit,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
@@ -57,13 +65,19 @@ func favouritesShow(
 	var favourites model.StatusList
 	if err := client.Call(
 		"GTSClient.GetFavourites",
-		limit,
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
 		&favourites,
 	); err != nil {
 		return fmt.Errorf("error retrieving the list of your favourite statuses: %w", err)
 	}
 
-	if len(favourites.Statuses) > 0 {
+	if len(favourites.Statuses) > 0 || !printSettings.TextOutput() {
 		var myAccountID string
 		if err := client.Call(
 			"GTSClient.GetMyAccountID",
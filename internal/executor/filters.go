@@ -50,7 +50,7 @@ func filtersShow(
 		return fmt.Errorf("error retrieving the list of filters: %w", err)
 	}
 
-	if len(filters) == 0 {
+	if len(filters) == 0 && printSettings.TextOutput() {
 		printer.PrintInfo("You have no filters.\n")
 
 		return nil
@@ -74,6 +74,9 @@ func followersShowFromAccount(
 		accountName string
 		limit       int
 		myAccount   bool
+		maxID       string
+		sinceID     string
+		all         bool
 	)
 
 	// Parse the remaining flags
@@ -81,6 +84,9 @@ func followersShowFromAccount(
 		&accountName,
 		&limit,
 		&myAccount,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
@@ -119,14 +125,20 @@ func followersShowFromAccount(
 		"GTSClient.GetFollowers",
 		gtsclient.GetFollowersArgs{
 			AccountID: accountID,
-			Limit:     limit,
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   limit,
+				MaxID:   maxID,
+				MinID:   "",
+				SinceID: sinceID,
+				All:     all,
+			},
 		},
 		&followers,
 	); err != nil {
 		return fmt.Errorf("error retrieving the list of followers: %w", err)
 	}
 
-	if len(followers.Accounts) > 0 {
+	if len(followers.Accounts) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintAccountList(printSettings, followers); err != nil {
 			return fmt.Errorf("error printing the list of followers: %w", err)
 		}
@@ -2,12 +2,15 @@ package executor
 
 import (
 	"fmt"
+	"io"
 	"net/rpc"
+	"strconv"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/mastodoncsv"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
@@ -37,6 +40,15 @@ func followingsFunc(
 			cmd.RelatedTarget,
 			cmd.RelatedTargetFlags,
 		)
+	case cli.ActionExport:
+		return followingsExport(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionImport:
+		return followingsImport(
+			session.Client(),
+			printSettings,
+			cfg.CacheDirectory,
+			cmd.FocusedTargetFlags,
+		)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetFollowings}
 	}
@@ -74,6 +86,9 @@ func followingsShowFromAccount(
 		accountName string
 		limit       int
 		myAccount   bool
+		maxID       string
+		sinceID     string
+		all         bool
 	)
 
 	// Parse the remaining flags
@@ -81,6 +96,9 @@ func followingsShowFromAccount(
 		&accountName,
 		&limit,
 		&myAccount,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
@@ -111,14 +129,20 @@ func followingsShowFromAccount(
 		"GTSClient.GetFollowing",
 		gtsclient.GetFollowingsArgs{
 			AccountID: accountID,
-			Limit:     limit,
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   limit,
+				MaxID:   maxID,
+				MinID:   "",
+				SinceID: sinceID,
+				All:     all,
+			},
 		},
 		&followings,
 	); err != nil {
 		return fmt.Errorf("error retrieving the list of followings: %w", err)
 	}
 
-	if len(followings.Accounts) > 0 {
+	if len(followings.Accounts) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintAccountList(printSettings, followings); err != nil {
 			return fmt.Errorf("error printing the list of followings: %w", err)
 		}
@@ -128,3 +152,154 @@ func followingsShowFromAccount(
 
 	return nil
 }
+
+// followingsExport saves the accounts that you are following to a CSV file
+// in the format of Mastodon's following_accounts.csv file.
+func followingsExport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var file string
+
+	// Parse the remaining flags.
+	if err := cli.ParseFollowingsExportFlags(
+		&file,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var myAccountID string
+	if err := client.Call(
+		"GTSClient.GetMyAccountID",
+		gtsclient.NoRPCArgs{},
+		&myAccountID,
+	); err != nil {
+		return fmt.Errorf("error getting your account ID: %w", err)
+	}
+
+	var followings model.AccountList
+	if err := client.Call(
+		"GTSClient.GetFollowing",
+		gtsclient.GetFollowingsArgs{
+			AccountID: myAccountID,
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   80,
+				MaxID:   "",
+				MinID:   "",
+				SinceID: "",
+				All:     true,
+			},
+		},
+		&followings,
+	); err != nil {
+		return fmt.Errorf("error retrieving the list of followings: %w", err)
+	}
+
+	accountAddress, err := accountAddressFunc(client)
+	if err != nil {
+		return err
+	}
+
+	records := make([]mastodoncsv.Following, len(followings.Accounts))
+
+	for idx, account := range followings.Accounts {
+		var relationship model.AccountRelationship
+		if err := client.Call(
+			"GTSClient.GetAccountRelationship",
+			account.ID,
+			&relationship,
+		); err != nil {
+			return fmt.Errorf("unable to retrieve the relationship to %s: %w", account.Acct, err)
+		}
+
+		records[idx] = mastodoncsv.Following{
+			Line:       idx + 2,
+			Account:    accountAddress(account),
+			ShowBoosts: relationship.ShowingReblogs,
+			Notify:     relationship.Notifying,
+			Languages:  nil,
+		}
+	}
+
+	if err := writeCSVFile(file, func(writer io.Writer) error {
+		return mastodoncsv.WriteFollowing(writer, records)
+	}); err != nil {
+		return err
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"Successfully exported "+strconv.Itoa(len(records))+" followings to "+file+".",
+	)
+
+	return nil
+}
+
+// followingsImport follows the accounts from a CSV file in the format
+// of Mastodon's following_accounts.csv file.
+func followingsImport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	cacheRoot string,
+	flags []string,
+) error {
+	var (
+		file   string
+		dryRun bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseFollowingsImportFlags(
+		&file,
+		&dryRun,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	data, err := readCSVFile(file)
+	if err != nil {
+		return err
+	}
+
+	readRecords := func(reader io.Reader) ([]csvImportRecord, error) {
+		followings, err := mastodoncsv.ReadFollowing(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]csvImportRecord, len(followings))
+
+		for idx, following := range followings {
+			records[idx] = csvImportRecord{
+				line:        following.Line,
+				account:     following.Account,
+				description: "follow " + following.Account,
+				apply: func(accountID string) error {
+					return client.Call(
+						"GTSClient.FollowAccount",
+						gtsclient.FollowAccountArgs{
+							AccountID:   accountID,
+							ShowReposts: following.ShowBoosts,
+							Notify:      following.Notify,
+						},
+						nil,
+					)
+				},
+			}
+		}
+
+		return records, nil
+	}
+
+	return csvImport{
+		name:        "IMPORT FOLLOWINGS",
+		target:      cli.TargetFollowings,
+		data:        data,
+		cacheRoot:   cacheRoot,
+		dryRun:      dryRun,
+		readRecords: readRecords,
+	}.run(client, printSettings)
+}
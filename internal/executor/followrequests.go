@@ -7,6 +7,7 @@ import (
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
@@ -47,21 +48,39 @@ func followRequestsShow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var limit int
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
 
 	if err := cli.ParseFollowRequestsShowFlags(
 		&limit,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
 	}
 
 	var requests model.AccountList
-	if err := client.Call("GTSClient.GetFollowRequests", limit, &requests); err != nil {
+	if err := client.Call(
+		"GTSClient.GetFollowRequests",
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
+		&requests,
+	); err != nil {
 		return fmt.Errorf("unable to retrieve the list of follow requests: %w", err)
 	}
 
-	if len(requests.Accounts) > 0 {
+	if len(requests.Accounts) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintAccountList(printSettings, requests); err != nil {
 			return fmt.Errorf("error printing the list of follow requests: %w", err)
 		}
@@ -1,11 +1,17 @@
 package executor
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"net/rpc"
+	"os"
+	"strings"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 )
 
 func getAccountsFromList(client *rpc.Client, listID string) (map[string]string, error) {
@@ -14,7 +20,13 @@ func getAccountsFromList(client *rpc.Client, listID string) (map[string]string,
 		"GTSClient.GetAccountsFromList",
 		gtsclient.GetAccountsFromListArgs{
 			ListID: listID,
-			Limit:  0,
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   0,
+				MaxID:   "",
+				MinID:   "",
+				SinceID: "",
+				All:     false,
+			},
 		},
 		&acctList,
 	); err != nil {
@@ -33,3 +45,32 @@ func getAccountsFromList(client *rpc.Client, listID string) (map[string]string,
 
 	return acctMap, nil
 }
+
+// stdinIsTerminal returns true if standard input is connected to a terminal.
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// confirm asks you to confirm an action and returns true if you answered yes.
+// The question is printed to standard error so that standard output only
+// contains the data printed by the action.
+func confirm(question string) (bool, error) {
+	printer.PrintPrompt(question + " [y/N]: ")
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("unable to read your answer: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
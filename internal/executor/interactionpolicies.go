@@ -0,0 +1,247 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+	"slices"
+	"strings"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// interactionPolicyKeywords are the values accepted by the instance for the groups
+// of accounts in an interaction policy. A specific account is specified by its URI.
+var interactionPolicyKeywords = []string{ //nolint:gochecknoglobals
+	"public",
+	"followers",
+	"following",
+	"mutuals",
+	"mentioned",
+	"author",
+	"me",
+}
+
+// interactionPoliciesFunc is the function for the interaction-policies target
+// for managing your default interaction policies.
+func interactionPoliciesFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return interactionPoliciesShow(session.Client(), printSettings)
+	case cli.ActionEdit:
+		return interactionPoliciesEdit(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetInteractionPolicies}
+	}
+}
+
+func interactionPoliciesShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+) error {
+	var policies model.DefaultInteractionPolicies
+	if err := client.Call(
+		"GTSClient.GetDefaultInteractionPolicies",
+		gtsclient.NoRPCArgs{},
+		&policies,
+	); err != nil {
+		return fmt.Errorf("error retrieving your default interaction policies: %w", err)
+	}
+
+	if err := printer.PrintDefaultInteractionPolicies(printSettings, policies); err != nil {
+		return fmt.Errorf("error printing your default interaction policies: %w", err)
+	}
+
+	return nil
+}
+
+func interactionPoliciesEdit(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		statusVisibility         internalFlag.EnumValue
+		canFavouriteAlways       = internalFlag.NewMultiStringValue()
+		canFavouriteWithApproval = internalFlag.NewMultiStringValue()
+		canReblogAlways          = internalFlag.NewMultiStringValue()
+		canReblogWithApproval    = internalFlag.NewMultiStringValue()
+		canReplyAlways           = internalFlag.NewMultiStringValue()
+		canReplyWithApproval     = internalFlag.NewMultiStringValue()
+		reset                    bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseInteractionPoliciesEditFlags(
+		&statusVisibility,
+		&canFavouriteAlways,
+		&canFavouriteWithApproval,
+		&canReblogAlways,
+		&canReblogWithApproval,
+		&canReplyAlways,
+		&canReplyWithApproval,
+		&reset,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if statusVisibility.Value() == "" {
+		return missingValueError{
+			valueType: "status visibility",
+			target:    cli.TargetInteractionPolicies,
+			action:    cli.ActionEdit,
+		}
+	}
+
+	var current model.DefaultInteractionPolicies
+	if err := client.Call(
+		"GTSClient.GetDefaultInteractionPolicies",
+		gtsclient.NoRPCArgs{},
+		&current,
+	); err != nil {
+		return fmt.Errorf("error retrieving your default interaction policies: %w", err)
+	}
+
+	// The instance resets the policy of every visibility that is missing from
+	// the form so the current policies are sent along with the updated policy.
+	form := gtsclient.UpdateDefaultInteractionPoliciesForm{
+		Direct:   &current.Direct,
+		Private:  &current.Private,
+		Public:   &current.Public,
+		Unlisted: &current.Unlisted,
+	}
+
+	var policy **model.InteractionPolicy
+
+	switch statusVisibility.Value() {
+	case "direct":
+		policy = &form.Direct
+	case "private":
+		policy = &form.Private
+	case "unlisted":
+		policy = &form.Unlisted
+	default:
+		policy = &form.Public
+	}
+
+	if reset {
+		*policy = nil
+	} else {
+		updates := []struct {
+			rules        *model.PolicyRules
+			always       []string
+			withApproval []string
+		}{
+			{rules: &(*policy).CanFavourite, always: canFavouriteAlways.Values(), withApproval: canFavouriteWithApproval.Values()},
+			{rules: &(*policy).CanReblog, always: canReblogAlways.Values(), withApproval: canReblogWithApproval.Values()},
+			{rules: &(*policy).CanReply, always: canReplyAlways.Values(), withApproval: canReplyWithApproval.Values()},
+		}
+
+		for _, update := range updates {
+			rules, err := interactionPolicyRules(update.always, update.withApproval)
+			if err != nil {
+				return err
+			}
+
+			if rules != nil {
+				*update.rules = *rules
+			}
+		}
+	}
+
+	var updated model.DefaultInteractionPolicies
+	if err := client.Call(
+		"GTSClient.UpdateDefaultInteractionPolicies",
+		form,
+		&updated,
+	); err != nil {
+		return fmt.Errorf("error updating your default interaction policies: %w", err)
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"Successfully updated your default interaction policy for "+statusVisibility.Value()+" statuses.",
+	)
+
+	return nil
+}
+
+// interactionPolicyRules validates the values of the policy flags for a single action
+// and returns the rules for that action. Nil is returned if no values are specified
+// so that the existing (or default) rules are used.
+func interactionPolicyRules(always, withApproval []string) (*model.PolicyRules, error) {
+	if len(always) == 0 && len(withApproval) == 0 {
+		return nil, nil
+	}
+
+	for _, value := range slices.Concat(always, withApproval) {
+		if !slices.Contains(interactionPolicyKeywords, value) &&
+			!strings.HasPrefix(value, "https://") &&
+			!strings.HasPrefix(value, "http://") {
+			return nil, invalidInteractionPolicyValueError{value: value}
+		}
+	}
+
+	return &model.PolicyRules{
+		Always:       append(make([]string, 0, len(always)), always...),
+		WithApproval: append(make([]string, 0, len(withApproval)), withApproval...),
+	}, nil
+}
+
+// newStatusInteractionPolicy creates the interaction policy of the new status from
+// the policy flags. Nil is returned if none of the policy flags are specified.
+func newStatusInteractionPolicy(newStatus model.DraftStatus) (*gtsclient.InteractionPolicyForm, error) {
+	canFavourite, err := interactionPolicyRules(newStatus.CanFavouriteAlways, newStatus.CanFavouriteWithApproval)
+	if err != nil {
+		return nil, err
+	}
+
+	canReblog, err := interactionPolicyRules(newStatus.CanReblogAlways, newStatus.CanReblogWithApproval)
+	if err != nil {
+		return nil, err
+	}
+
+	canReply, err := interactionPolicyRules(newStatus.CanReplyAlways, newStatus.CanReplyWithApproval)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case canFavourite != nil && newStatus.NotLikeable:
+		return nil, conflictingInteractionPolicyError{flag: "not-likeable", policy: "can-favourite"}
+	case canReblog != nil && newStatus.NotBoostable:
+		return nil, conflictingInteractionPolicyError{flag: "not-boostable", policy: "can-reblog"}
+	case canReply != nil && newStatus.NotReplyable:
+		return nil, conflictingInteractionPolicyError{flag: "not-replyable", policy: "can-reply"}
+	case canFavourite == nil && canReblog == nil && canReply == nil:
+		return nil, nil
+	}
+
+	return &gtsclient.InteractionPolicyForm{
+		CanFavourite: canFavourite,
+		CanReblog:    canReblog,
+		CanReply:     canReply,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// interactionRequestFunc is the function for the interaction-request target for
+// managing a pending request to interact with one of your statuses.
+func interactionRequestFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return interactionRequestShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionAccept:
+		return interactionRequestAccept(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionReject:
+		return interactionRequestReject(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetInteractionRequest}
+	}
+}
+
+func interactionRequestShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var requestID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseInteractionRequestShowFlags(
+		&requestID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if requestID == "" {
+		return missingIDError{
+			target: cli.TargetInteractionRequest,
+			action: cli.ActionShow,
+		}
+	}
+
+	var request model.InteractionRequest
+	if err := client.Call(
+		"GTSClient.GetInteractionRequest",
+		requestID,
+		&request,
+	); err != nil {
+		return fmt.Errorf("error retrieving the interaction request: %w", err)
+	}
+
+	if err := printer.PrintInteractionRequest(printSettings, request); err != nil {
+		return fmt.Errorf("error printing the interaction request: %w", err)
+	}
+
+	return nil
+}
+
+func interactionRequestAccept(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var requestID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseInteractionRequestAcceptFlags(
+		&requestID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if requestID == "" {
+		return missingIDError{
+			target: cli.TargetInteractionRequest,
+			action: cli.ActionAccept,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.AcceptInteractionRequest",
+		requestID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error accepting the interaction request: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully accepted the interaction request.")
+
+	return nil
+}
+
+func interactionRequestReject(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var requestID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseInteractionRequestRejectFlags(
+		&requestID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if requestID == "" {
+		return missingIDError{
+			target: cli.TargetInteractionRequest,
+			action: cli.ActionReject,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.RejectInteractionRequest",
+		requestID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error rejecting the interaction request: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully rejected the interaction request.")
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// interactionRequestsFunc is the function for the interaction-requests target
+// for viewing the pending requests to interact with your statuses.
+func interactionRequestsFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return interactionRequestsShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetInteractionRequests}
+	}
+}
+
+func interactionRequestsShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		interactionType internalFlag.MultiEnumValue
+		statusID        string
+		limit           int
+		maxID           string
+		sinceID         string
+		all             bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseInteractionRequestsShowFlags(
+		&interactionType,
+		&statusID,
+		&limit,
+		&maxID,
+		&sinceID,
+		&all,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var list model.InteractionRequestList
+	if err := client.Call(
+		"GTSClient.GetInteractionRequestList",
+		gtsclient.GetInteractionRequestListArgs{
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   limit,
+				MaxID:   maxID,
+				MinID:   "",
+				SinceID: sinceID,
+				All:     all,
+			},
+			StatusID: statusID,
+			Types:    interactionType.Values(),
+		},
+		&list,
+	); err != nil {
+		return fmt.Errorf("error retrieving the list of interaction requests: %w", err)
+	}
+
+	if len(list.InteractionRequests) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("You have no pending interaction requests.\n")
+
+		return nil
+	}
+
+	if err := printer.PrintInteractionRequestList(printSettings, list); err != nil {
+		return fmt.Errorf("error printing the list of interaction requests: %w", err)
+	}
+
+	return nil
+}
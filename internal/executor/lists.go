@@ -2,12 +2,15 @@ package executor
 
 import (
 	"fmt"
+	"io"
 	"net/rpc"
+	"strconv"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/mastodoncsv"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
@@ -34,6 +37,15 @@ func listsFunc(
 	switch cmd.Action {
 	case cli.ActionShow:
 		return listsShow(session.Client(), printSettings)
+	case cli.ActionExport:
+		return listsExport(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionImport:
+		return listsImport(
+			session.Client(),
+			printSettings,
+			cfg.CacheDirectory,
+			cmd.FocusedTargetFlags,
+		)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetLists}
 	}
@@ -52,7 +64,7 @@ func listsShow(
 		return fmt.Errorf("unable to retrieve the lists: %w", err)
 	}
 
-	if len(lists) == 0 {
+	if len(lists) == 0 && printSettings.TextOutput() {
 		printer.PrintInfo("You have no lists.\n")
 
 		return nil
@@ -64,3 +76,193 @@ func listsShow(
 
 	return nil
 }
+
+// listsExport saves your lists and their members to a CSV file
+// in the format of Mastodon's lists.csv file.
+func listsExport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var file string
+
+	// Parse the remaining flags.
+	if err := cli.ParseListsExportFlags(
+		&file,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var lists []model.List
+	if err := client.Call(
+		"GTSClient.GetAllLists",
+		gtsclient.NoRPCArgs{},
+		&lists,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve the lists: %w", err)
+	}
+
+	accountAddress, err := accountAddressFunc(client)
+	if err != nil {
+		return err
+	}
+
+	records := make([]mastodoncsv.ListMember, 0)
+
+	for _, list := range lists {
+		var members model.AccountList
+		if err := client.Call(
+			"GTSClient.GetAccountsFromList",
+			gtsclient.GetAccountsFromListArgs{
+				ListID: list.ID,
+				Pagination: gtsclient.PaginationArgs{
+					Limit:   80,
+					MaxID:   "",
+					MinID:   "",
+					SinceID: "",
+					All:     true,
+				},
+			},
+			&members,
+		); err != nil {
+			return fmt.Errorf("unable to retrieve the accounts from the list %q: %w", list.Title, err)
+		}
+
+		for _, account := range members.Accounts {
+			records = append(records, mastodoncsv.ListMember{
+				Line:     len(records) + 1,
+				ListName: list.Title,
+				Account:  accountAddress(account),
+			})
+		}
+	}
+
+	if err := writeCSVFile(file, func(writer io.Writer) error {
+		return mastodoncsv.WriteLists(writer, records)
+	}); err != nil {
+		return err
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"Successfully exported "+strconv.Itoa(len(lists))+" lists to "+file+".",
+	)
+
+	return nil
+}
+
+// listsImport adds the accounts from a CSV file in the format of Mastodon's lists.csv
+// file to your lists. The lists that do not exist are created.
+func listsImport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	cacheRoot string,
+	flags []string,
+) error {
+	var (
+		file   string
+		dryRun bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseListsImportFlags(
+		&file,
+		&dryRun,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	data, err := readCSVFile(file)
+	if err != nil {
+		return err
+	}
+
+	var lists []model.List
+	if err := client.Call(
+		"GTSClient.GetAllLists",
+		gtsclient.NoRPCArgs{},
+		&lists,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve the lists: %w", err)
+	}
+
+	listIDs := make(map[string]string)
+	for _, list := range lists {
+		listIDs[list.Title] = list.ID
+	}
+
+	// getListID returns the ID of the list with the specified name,
+	// creating the list if it does not exist.
+	getListID := func(name string) (string, error) {
+		if listID, ok := listIDs[name]; ok {
+			return listID, nil
+		}
+
+		var list model.List
+		if err := client.Call(
+			"GTSClient.CreateList",
+			gtsclient.CreateListArgs{
+				Title:         name,
+				RepliesPolicy: "list",
+				Exclusive:     false,
+			},
+			&list,
+		); err != nil {
+			return "", fmt.Errorf("unable to create the list %q: %w", name, err)
+		}
+
+		listIDs[name] = list.ID
+
+		return list.ID, nil
+	}
+
+	readRecords := func(reader io.Reader) ([]csvImportRecord, error) {
+		members, err := mastodoncsv.ReadLists(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]csvImportRecord, len(members))
+
+		for idx := range members {
+			description := "add " + members[idx].Account + " to the list " + strconv.Quote(members[idx].ListName)
+			if _, ok := listIDs[members[idx].ListName]; !ok {
+				description += " (the list will be created)"
+			}
+
+			records[idx] = csvImportRecord{
+				line:        members[idx].Line,
+				account:     members[idx].Account,
+				description: description,
+				apply: func(accountID string) error {
+					listID, err := getListID(members[idx].ListName)
+					if err != nil {
+						return err
+					}
+
+					return client.Call(
+						"GTSClient.AddAccountsToList",
+						gtsclient.AddAccountsToListArgs{
+							ListID:     listID,
+							AccountIDs: []string{accountID},
+						},
+						nil,
+					)
+				},
+			}
+		}
+
+		return records, nil
+	}
+
+	return csvImport{
+		name:        "IMPORT LISTS",
+		target:      cli.TargetLists,
+		data:        data,
+		cacheRoot:   cacheRoot,
+		dryRun:      dryRun,
+		readRecords: readRecords,
+	}.run(client, printSettings)
+}
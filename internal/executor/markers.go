@@ -0,0 +1,95 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+// unreadPagination returns the pagination arguments for retrieving the unread items
+// of the timeline along with your last read position. The oldest unread items are
+// retrieved first so that repeated calls work through the unread items in order.
+func unreadPagination(
+	client *rpc.Client,
+	timeline string,
+	limit int,
+	maxID string,
+	sinceID string,
+	all bool,
+) (gtsclient.PaginationArgs, string, error) {
+	switch {
+	case maxID != "":
+		return gtsclient.PaginationArgs{}, "", unreadConflictError{flag: "max-id"}
+	case sinceID != "":
+		return gtsclient.PaginationArgs{}, "", unreadConflictError{flag: "since-id"}
+	case all:
+		return gtsclient.PaginationArgs{}, "", unreadConflictError{flag: "all"}
+	}
+
+	var markers model.Markers
+	if err := client.Call("GTSClient.GetMarkers", gtsclient.NoRPCArgs{}, &markers); err != nil {
+		return gtsclient.PaginationArgs{}, "", fmt.Errorf("unable to retrieve your read positions: %w", err)
+	}
+
+	lastReadID := markers.Home.LastReadID
+	if timeline == gtsclient.MarkerNotifications {
+		lastReadID = markers.Notifications.LastReadID
+	}
+
+	pagination := gtsclient.PaginationArgs{
+		Limit:   limit,
+		MaxID:   "",
+		MinID:   lastReadID,
+		SinceID: "",
+		All:     false,
+	}
+
+	return pagination, lastReadID, nil
+}
+
+// advanceMarker moves your read position in the timeline to the newest of the
+// items that were shown.
+func advanceMarker(
+	client *rpc.Client,
+	timeline string,
+	lastReadID string,
+	shownIDs []string,
+) error {
+	newest := lastReadID
+
+	for _, id := range shownIDs {
+		if newerID(id, newest) {
+			newest = id
+		}
+	}
+
+	if newest == lastReadID {
+		return nil
+	}
+
+	var marker model.Marker
+	if err := client.Call(
+		"GTSClient.UpdateMarker",
+		gtsclient.UpdateMarkerArgs{
+			Timeline:   timeline,
+			LastReadID: newest,
+		},
+		&marker,
+	); err != nil {
+		return fmt.Errorf("unable to update your read position: %w", err)
+	}
+
+	return nil
+}
+
+// newerID returns true if the ID a is newer than the ID b. The IDs used by GoToSocial (ULIDs)
+// and Mastodon (snowflakes) both sort by creation time when compared by length and then lexically.
+func newerID(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+
+	return a > b
+}
@@ -0,0 +1,252 @@
+package executor
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/rpc"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/mastodoncsv"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+// importProgressInterval is the number of records imported between
+// each progress update.
+const importProgressInterval int = 25
+
+// csvImportRecord is a record from a Mastodon CSV file that is ready to be imported.
+type csvImportRecord struct {
+	line    int
+	account string
+
+	// description describes the change that is made when the record is imported.
+	description string
+
+	// apply imports the record for the account with the specified ID.
+	apply func(accountID string) error
+}
+
+// csvImport is an import of the records of a Mastodon CSV file.
+type csvImport struct {
+	name        string
+	target      string
+	data        []byte
+	cacheRoot   string
+	dryRun      bool
+	readRecords func(io.Reader) ([]csvImportRecord, error)
+}
+
+// run imports the records from the CSV file. The progress is saved after each record
+// so that an interrupted import is resumed from where it stopped. The records that
+// failed to import, whether the account could not be found or the change could not
+// be applied, are kept in the progress and retried the next time the file is imported.
+// The records are only checked if the import is a dry run.
+func (i csvImport) run(client *rpc.Client, printSettings printer.Settings) error {
+	records, err := i.readRecords(bytes.NewReader(i.data))
+	if err != nil {
+		return fmt.Errorf("unable to read the records from the CSV file: %w", err)
+	}
+
+	progressDir, err := accountCacheDirectory(client, i.cacheRoot, utilities.CalculateImportsCacheDir)
+	if err != nil {
+		return fmt.Errorf("unable to get the directory for the progress of your imports: %w", err)
+	}
+
+	progressID := mastodoncsv.ProgressID(i.target, i.data)
+
+	progress, err := mastodoncsv.LoadProgress(progressDir, progressID)
+	if err != nil {
+		return fmt.Errorf("unable to load the progress of the previous import: %w", err)
+	}
+
+	report := model.ImportReport{
+		Name:      i.name,
+		DryRun:    i.dryRun,
+		Total:     len(records),
+		Resumed:   min(progress.Processed, len(records)),
+		Retried:   0,
+		Succeeded: 0,
+		Planned:   make([]string, 0),
+		Failed:    make([]model.ImportFailure, 0),
+	}
+
+	// retry contains the line numbers of the records that failed in the previous
+	// import and have not been retried yet.
+	retry := make(map[int]struct{})
+	for _, line := range progress.Failed {
+		retry[line] = struct{}{}
+	}
+
+	if report.Resumed > 0 && printSettings.TextOutput() {
+		printer.PrintInfo(
+			"Resuming the previous import after record " + strconv.Itoa(report.Resumed) +
+				" and retrying " + strconv.Itoa(len(retry)) + " failed records.\n",
+		)
+	}
+
+	processed := report.Resumed
+
+	for idx := range records {
+		record := records[idx]
+
+		if idx < report.Resumed {
+			if _, ok := retry[record.line]; !ok {
+				continue
+			}
+
+			delete(retry, record.line)
+			report.Retried++
+		}
+
+		if err := i.importRecord(client, record, &report); err != nil {
+			report.Failed = append(report.Failed, model.ImportFailure{
+				Line:    record.line,
+				Account: record.account,
+				Reason:  err.Error(),
+			})
+		}
+
+		if i.dryRun {
+			continue
+		}
+
+		processed = max(processed, idx+1)
+
+		if err := mastodoncsv.SaveProgress(
+			progressDir,
+			progressID,
+			mastodoncsv.Progress{Processed: processed, Failed: failedLines(report.Failed, retry), UpdatedAt: time.Now().UTC()},
+		); err != nil {
+			return fmt.Errorf("unable to save the progress of the import: %w", err)
+		}
+
+		if idx >= report.Resumed && (idx+1)%importProgressInterval == 0 && printSettings.TextOutput() {
+			printer.PrintInfo("Imported " + strconv.Itoa(idx+1) + " of " + strconv.Itoa(len(records)) + " records...\n")
+		}
+	}
+
+	// The progress is kept while there are failed records so that they are
+	// retried the next time the file is imported.
+	if !i.dryRun && len(report.Failed) == 0 && len(retry) == 0 {
+		if err := mastodoncsv.DeleteProgress(progressDir, progressID); err != nil {
+			return fmt.Errorf("unable to delete the progress of the completed import: %w", err)
+		}
+	}
+
+	if err := printer.PrintImportReport(printSettings, report); err != nil {
+		return fmt.Errorf("error printing the report of the import: %w", err)
+	}
+
+	return nil
+}
+
+// importRecord looks up the account of the record and applies the change. The change
+// is only added to the planned changes of the report if the import is a dry run.
+func (i csvImport) importRecord(client *rpc.Client, record csvImportRecord, report *model.ImportReport) error {
+	var accountID string
+	if err := client.Call("GTSClient.GetAccountID", record.account, &accountID); err != nil {
+		return err
+	}
+
+	if i.dryRun {
+		report.Planned = append(report.Planned, record.description)
+
+		return nil
+	}
+
+	if err := record.apply(accountID); err != nil {
+		return err
+	}
+
+	report.Succeeded++
+
+	return nil
+}
+
+// failedLines returns the line numbers of the records that failed to import
+// and of the records from the previous import that are still to be retried.
+func failedLines(failures []model.ImportFailure, retry map[int]struct{}) []int {
+	lines := make([]int, 0, len(failures)+len(retry))
+
+	for idx := range failures {
+		lines = append(lines, failures[idx].Line)
+	}
+
+	for line := range retry {
+		lines = append(lines, line)
+	}
+
+	slices.Sort(lines)
+
+	return lines
+}
+
+// readCSVFile reads the contents of the CSV file to import.
+func readCSVFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, missingCSVFileError{}
+	}
+
+	file, err := utilities.OpenFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", path, err)
+	}
+
+	return data, nil
+}
+
+// writeCSVFile writes the exported records to the CSV file.
+func writeCSVFile(path string, write func(io.Writer) error) error {
+	if path == "" {
+		return missingCSVFileError{}
+	}
+
+	file, err := utilities.CreateFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to create %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if err := write(file); err != nil {
+		return fmt.Errorf("unable to write the records to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// accountAddressFunc returns a function that returns the full address of an account
+// (e.g. user@social.example). The address of an account on your instance does not
+// include the domain so the domain of your instance is added to it.
+func accountAddressFunc(client *rpc.Client) (func(model.Account) string, error) {
+	var instance string
+	if err := client.Call(
+		"GTSClient.GetInstanceURL",
+		gtsclient.NoRPCArgs{},
+		&instance,
+	); err != nil {
+		return nil, fmt.Errorf("unable to get the instance URL: %w", err)
+	}
+
+	domain := utilities.GetFQDN(instance)
+
+	return func(account model.Account) string {
+		if strings.Contains(account.Acct, "@") {
+			return account.Acct
+		}
+
+		return account.Acct + "@" + domain
+	}, nil
+}
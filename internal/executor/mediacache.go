@@ -0,0 +1,94 @@
+package executor
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/media"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+)
+
+// mediaCacheFunc is the function for the media-cache target for managing
+// the media files downloaded from your instances.
+func mediaCacheFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return mediaCacheShow(cfg.CacheDirectory, printSettings)
+	case cli.ActionClear:
+		return mediaCacheClear(cfg.CacheDirectory, printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetMediaCache}
+	}
+}
+
+func mediaCacheShow(
+	cacheRoot string,
+	printSettings printer.Settings,
+) error {
+	cache, err := media.CacheSummary(cacheRoot)
+	if err != nil {
+		return fmt.Errorf("unable to calculate the size of the media cache: %w", err)
+	}
+
+	if len(cache.Instances) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("There are no downloaded media files in the cache.\n")
+
+		return nil
+	}
+
+	if err := printer.PrintMediaCache(printSettings, cache); err != nil {
+		return fmt.Errorf("error printing the media cache: %w", err)
+	}
+
+	return nil
+}
+
+func mediaCacheClear(
+	cacheRoot string,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		olderThan = internalFlag.NewTimeDurationValue(time.Duration(0))
+		maxSize   int
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseMediaCacheClearFlags(
+		&olderThan,
+		&maxSize,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if maxSize < 0 {
+		return negativeMaxSizeError{}
+	}
+
+	report, err := media.PruneCache(cacheRoot, olderThan.Value(), media.MaxSizeFromMebibytes(maxSize))
+	if err != nil {
+		return fmt.Errorf("unable to clear the media cache: %w", err)
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"You have successfully removed "+strconv.Itoa(report.RemovedFiles)+
+			" media files ("+printer.FormatSize(report.RemovedSize)+") from the cache.",
+	)
+
+	return nil
+}
@@ -2,11 +2,15 @@ package executor
 
 import (
 	"fmt"
+	"io"
 	"net/rpc"
+	"strconv"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/mastodoncsv"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
@@ -31,6 +35,15 @@ func mutedAccountsFunc(
 	switch cmd.Action {
 	case cli.ActionShow:
 		return mutedAccountsShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionExport:
+		return mutedAccountsExport(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionImport:
+		return mutedAccountsImport(
+			session.Client(),
+			printSettings,
+			cfg.CacheDirectory,
+			cmd.FocusedTargetFlags,
+		)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetMutedAccounts}
 	}
@@ -41,11 +54,19 @@ func mutedAccountsShow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var limit int
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
 
 	// Parse the remaining flags.
 	if err := cli.ParseMutedAccountsShowFlags(
 		&limit,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
@@ -54,13 +75,19 @@ func mutedAccountsShow(
 	var muted model.AccountList
 	if err := client.Call(
 		"GTSClient.GetMutedAccounts",
-		limit,
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
 		&muted,
 	); err != nil {
 		return fmt.Errorf("error retrieving the list of muted accounts: %w", err)
 	}
 
-	if len(muted.Accounts) > 0 {
+	if len(muted.Accounts) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintAccountList(printSettings, muted); err != nil {
 			return fmt.Errorf("error printing the list of muted accounts: %w", err)
 		}
@@ -70,3 +97,145 @@ func mutedAccountsShow(
 
 	return nil
 }
+
+// mutedAccountsExport saves the accounts that you have muted to a CSV file
+// in the format of Mastodon's muted_accounts.csv file.
+func mutedAccountsExport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var file string
+
+	// Parse the remaining flags.
+	if err := cli.ParseMutedAccountsExportFlags(
+		&file,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var muted model.AccountList
+	if err := client.Call(
+		"GTSClient.GetMutedAccounts",
+		gtsclient.PaginationArgs{
+			Limit:   80,
+			MaxID:   "",
+			MinID:   "",
+			SinceID: "",
+			All:     true,
+		},
+		&muted,
+	); err != nil {
+		return fmt.Errorf("error retrieving the list of muted accounts: %w", err)
+	}
+
+	accountAddress, err := accountAddressFunc(client)
+	if err != nil {
+		return err
+	}
+
+	records := make([]mastodoncsv.Muted, len(muted.Accounts))
+
+	for idx, account := range muted.Accounts {
+		var relationship model.AccountRelationship
+		if err := client.Call(
+			"GTSClient.GetAccountRelationship",
+			account.ID,
+			&relationship,
+		); err != nil {
+			return fmt.Errorf("unable to retrieve the relationship to %s: %w", account.Acct, err)
+		}
+
+		records[idx] = mastodoncsv.Muted{
+			Line:              idx + 2,
+			Account:           accountAddress(account),
+			HideNotifications: relationship.MutingNotifications,
+		}
+	}
+
+	if err := writeCSVFile(file, func(writer io.Writer) error {
+		return mastodoncsv.WriteMuted(writer, records)
+	}); err != nil {
+		return err
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"Successfully exported "+strconv.Itoa(len(records))+" muted accounts to "+file+".",
+	)
+
+	return nil
+}
+
+// mutedAccountsImport mutes the accounts from a CSV file in the format
+// of Mastodon's muted_accounts.csv file. The accounts are muted indefinitely.
+func mutedAccountsImport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	cacheRoot string,
+	flags []string,
+) error {
+	var (
+		file   string
+		dryRun bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseMutedAccountsImportFlags(
+		&file,
+		&dryRun,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	data, err := readCSVFile(file)
+	if err != nil {
+		return err
+	}
+
+	readRecords := func(reader io.Reader) ([]csvImportRecord, error) {
+		muted, err := mastodoncsv.ReadMuted(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]csvImportRecord, len(muted))
+
+		for idx := range muted {
+			description := "mute " + muted[idx].Account
+			if muted[idx].HideNotifications {
+				description += " and hide their notifications"
+			}
+
+			records[idx] = csvImportRecord{
+				line:        muted[idx].Line,
+				account:     muted[idx].Account,
+				description: description,
+				apply: func(accountID string) error {
+					return client.Call(
+						"GTSClient.MuteAccount",
+						gtsclient.MuteAccountArgs{
+							AccountID:     accountID,
+							Notifications: muted[idx].HideNotifications,
+							Duration:      0,
+						},
+						nil,
+					)
+				},
+			}
+		}
+
+		return records, nil
+	}
+
+	return csvImport{
+		name:        "IMPORT MUTED ACCOUNTS",
+		target:      cli.TargetMutedAccounts,
+		data:        data,
+		cacheRoot:   cacheRoot,
+		dryRun:      dryRun,
+		readRecords: readRecords,
+	}.run(client, printSettings)
+}
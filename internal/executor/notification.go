@@ -34,6 +34,8 @@ func notificationFunc(
 	switch cmd.Action {
 	case cli.ActionShow:
 		return notificationShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionDismiss:
+		return notificationDismiss(session.Client(), printSettings, cmd.FocusedTargetFlags)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetNotification}
 	}
@@ -81,3 +83,38 @@ func notificationShow(
 
 	return nil
 }
+
+func notificationDismiss(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var notificationID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseNotificationDismissFlags(
+		&notificationID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if notificationID == "" {
+		return missingIDError{
+			target: cli.TargetNotification,
+			action: cli.ActionDismiss,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.DismissNotification",
+		notificationID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error dismissing the notification: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "You have successfully dismissed the notification.")
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// notificationPolicyFunc is the function for the notification-policy target
+// for managing the filtering of notifications from accounts you may not know.
+func notificationPolicyFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return notificationPolicyShow(session.Client(), printSettings)
+	case cli.ActionEdit:
+		return notificationPolicyEdit(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetNotificationPolicy}
+	}
+}
+
+func notificationPolicyShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+) error {
+	var policy model.NotificationPolicy
+	if err := client.Call(
+		"GTSClient.GetNotificationPolicy",
+		gtsclient.NoRPCArgs{},
+		&policy,
+	); err != nil {
+		return fmt.Errorf("error retrieving your notification policy: %w", err)
+	}
+
+	if err := printer.PrintNotificationPolicy(printSettings, policy); err != nil {
+		return fmt.Errorf("error printing your notification policy: %w", err)
+	}
+
+	return nil
+}
+
+func notificationPolicyEdit(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		forNotFollowing    internalFlag.EnumValue
+		forNotFollowers    internalFlag.EnumValue
+		forNewAccounts     internalFlag.EnumValue
+		forPrivateMentions internalFlag.EnumValue
+		forLimitedAccounts internalFlag.EnumValue
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseNotificationPolicyEditFlags(
+		&forNotFollowing,
+		&forNotFollowers,
+		&forNewAccounts,
+		&forPrivateMentions,
+		&forLimitedAccounts,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	form := gtsclient.UpdateNotificationPolicyForm{
+		ForNotFollowing:    forNotFollowing.Value(),
+		ForNotFollowers:    forNotFollowers.Value(),
+		ForNewAccounts:     forNewAccounts.Value(),
+		ForPrivateMentions: forPrivateMentions.Value(),
+		ForLimitedAccounts: forLimitedAccounts.Value(),
+	}
+
+	if form.IsZero() {
+		return zeroValuesError{
+			valueType: "notification policy",
+			action:    "update",
+		}
+	}
+
+	var policy model.NotificationPolicy
+	if err := client.Call(
+		"GTSClient.UpdateNotificationPolicy",
+		form,
+		&policy,
+	); err != nil {
+		return fmt.Errorf("error updating your notification policy: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully updated your notification policy.")
+
+	return nil
+}
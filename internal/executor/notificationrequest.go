@@ -0,0 +1,152 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// notificationRequestFunc is the function for the notification-request target for
+// managing the notifications from an account that have been filtered by your
+// notification policy.
+func notificationRequestFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return notificationRequestShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionAccept:
+		return notificationRequestAccept(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionDismiss:
+		return notificationRequestDismiss(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetNotificationRequest}
+	}
+}
+
+func notificationRequestShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var requestID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseNotificationRequestShowFlags(
+		&requestID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if requestID == "" {
+		return missingIDError{
+			target: cli.TargetNotificationRequest,
+			action: cli.ActionShow,
+		}
+	}
+
+	var request model.NotificationRequest
+	if err := client.Call(
+		"GTSClient.GetNotificationRequest",
+		requestID,
+		&request,
+	); err != nil {
+		return fmt.Errorf("error retrieving the notification request: %w", err)
+	}
+
+	if err := printer.PrintNotificationRequest(printSettings, request); err != nil {
+		return fmt.Errorf("error printing the notification request: %w", err)
+	}
+
+	return nil
+}
+
+func notificationRequestAccept(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var requestID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseNotificationRequestAcceptFlags(
+		&requestID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if requestID == "" {
+		return missingIDError{
+			target: cli.TargetNotificationRequest,
+			action: cli.ActionAccept,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.AcceptNotificationRequest",
+		requestID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error accepting the notification request: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully accepted the notification request.")
+
+	return nil
+}
+
+func notificationRequestDismiss(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var requestID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseNotificationRequestDismissFlags(
+		&requestID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if requestID == "" {
+		return missingIDError{
+			target: cli.TargetNotificationRequest,
+			action: cli.ActionDismiss,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.DismissNotificationRequest",
+		requestID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error dismissing the notification request: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully dismissed the notification request.")
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// notificationRequestsFunc is the function for the notification-requests target
+// for viewing the accounts whose notifications have been filtered.
+func notificationRequestsFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return notificationRequestsShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetNotificationRequests}
+	}
+}
+
+func notificationRequestsShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseNotificationRequestsShowFlags(
+		&limit,
+		&maxID,
+		&sinceID,
+		&all,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var list model.NotificationRequestList
+	if err := client.Call(
+		"GTSClient.GetNotificationRequestList",
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
+		&list,
+	); err != nil {
+		return fmt.Errorf("error retrieving the list of notification requests: %w", err)
+	}
+
+	if len(list.Requests) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("You have no pending notification requests.\n")
+
+		return nil
+	}
+
+	if err := printer.PrintNotificationRequestList(printSettings, list); err != nil {
+		return fmt.Errorf("error printing the list of notification requests: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package executor
 import (
 	"fmt"
 	"net/rpc"
+	"slices"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
@@ -37,6 +38,8 @@ func notificationsFunc(
 		return notificationsClear(session.Client(), printSettings)
 	case cli.ActionShow:
 		return notificationsShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionWatch:
+		return notificationsWatch(session.Client(), printSettings)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetNotifications}
 	}
@@ -68,6 +71,11 @@ func notificationsShow(
 		limit                   int
 		excludeNotificationType internalFlag.MultiEnumValue
 		includeNotificationType internalFlag.MultiEnumValue
+		maxID                   string
+		sinceID                 string
+		all                     bool
+		unread                  bool
+		group                   bool
 	)
 
 	// Parse the remaining flags.
@@ -75,21 +83,54 @@ func notificationsShow(
 		&limit,
 		&excludeNotificationType,
 		&includeNotificationType,
+		&maxID,
+		&sinceID,
+		&all,
+		&unread,
+		&group,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	pagination := gtsclient.PaginationArgs{
+		Limit:   limit,
+		MaxID:   maxID,
+		MinID:   "",
+		SinceID: sinceID,
+		All:     all,
+	}
+
+	var lastReadID string
+
+	if unread {
+		// Moving the read position past notifications that were filtered out would
+		// silently mark them as read in all of your clients.
+		switch {
+		case len(excludeNotificationType.Values()) > 0:
+			return unreadConflictError{flag: "exclude-type"}
+		case len(includeNotificationType.Values()) > 0:
+			return unreadConflictError{flag: "include-type"}
+		}
+
+		var err error
+
+		pagination, lastReadID, err = unreadPagination(client, gtsclient.MarkerNotifications, limit, maxID, sinceID, all)
+		if err != nil {
+			return err
+		}
+	}
+
 	var myAccountID string
 	if err := client.Call("GTSClient.GetMyAccountID", gtsclient.NoRPCArgs{}, &myAccountID); err != nil {
 		return fmt.Errorf("unable to get your account ID: %w", err)
 	}
 
-	var notificationList []model.Notification
+	var notificationList model.NotificationList
 	if err := client.Call(
 		"GTSClient.GetNotificationList",
 		gtsclient.GetNotificationListArgs{
-			Limit:        limit,
+			Pagination:   pagination,
 			ExcludeTypes: excludeNotificationType.Values(),
 			IncludeTypes: includeNotificationType.Values(),
 		},
@@ -101,7 +142,16 @@ func notificationsShow(
 		)
 	}
 
-	if len(notificationList) > 0 {
+	switch {
+	case group && (len(notificationList.Notifications) > 0 || !printSettings.TextOutput()):
+		if err := printer.PrintNotificationGroupList(
+			printSettings,
+			groupNotifications(notificationList),
+			myAccountID,
+		); err != nil {
+			return fmt.Errorf("error printing the list of grouped notifications: %w", err)
+		}
+	case len(notificationList.Notifications) > 0 || !printSettings.TextOutput():
 		if err := printer.PrintNotificationList(
 			printSettings,
 			notificationList,
@@ -109,9 +159,80 @@ func notificationsShow(
 		); err != nil {
 			return fmt.Errorf("error printing the list of notifications: %w", err)
 		}
-	} else {
+	case unread:
+		printer.PrintInfo("You have no unread notifications.\n")
+	default:
 		printer.PrintInfo("You have no notifications.\n")
 	}
 
-	return nil
+	if !unread {
+		return nil
+	}
+
+	shownIDs := make([]string, len(notificationList.Notifications))
+	for idx := range notificationList.Notifications {
+		shownIDs[idx] = notificationList.Notifications[idx].ID
+	}
+
+	return advanceMarker(client, gtsclient.MarkerNotifications, lastReadID, shownIDs)
+}
+
+// groupNotifications collapses the likes and boosts of the same status into a single group.
+// The groups are in the order of their most recent notification.
+func groupNotifications(list model.NotificationList) model.NotificationGroupList {
+	groups := make([]model.NotificationGroup, 0, len(list.Notifications))
+	groupIndex := make(map[string]int)
+
+	for _, notification := range list.Notifications {
+		var key string
+
+		if (notification.Type == "favourite" || notification.Type == "reblog") && notification.Status != nil {
+			key = notification.Type + ":" + notification.Status.ID
+		}
+
+		idx, ok := groupIndex[key]
+		if key == "" || !ok {
+			groups = append(groups, model.NotificationGroup{
+				Type:            notification.Type,
+				Accounts:        []model.Account{},
+				Status:          notification.Status,
+				NotificationIDs: []string{},
+				LatestAt:        notification.CreatedAt,
+			})
+
+			idx = len(groups) - 1
+
+			if key != "" {
+				groupIndex[key] = idx
+			}
+		}
+
+		groups[idx].NotificationIDs = append(groups[idx].NotificationIDs, notification.ID)
+
+		if notification.Account != nil && !slices.ContainsFunc(groups[idx].Accounts, func(account model.Account) bool {
+			return account.ID == notification.Account.ID
+		}) {
+			groups[idx].Accounts = append(groups[idx].Accounts, *notification.Account)
+		}
+	}
+
+	return model.NotificationGroupList{
+		Groups:     groups,
+		Pagination: list.Pagination,
+	}
+}
+
+func notificationsWatch(
+	client *rpc.Client,
+	printSettings printer.Settings,
+) error {
+	return watchStream(
+		client,
+		printSettings,
+		gtsclient.OpenStreamArgs{
+			Stream:  gtsclient.StreamNotification,
+			ListID:  "",
+			TagName: "",
+		},
+	)
 }
@@ -0,0 +1,169 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// scheduledStatusFunc is the function for the scheduled-status target for
+// interacting with a status that is scheduled to be published.
+func scheduledStatusFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return scheduledStatusShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionEdit:
+		return scheduledStatusEdit(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionDelete:
+		return scheduledStatusDelete(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetScheduledStatus}
+	}
+}
+
+func scheduledStatusShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var scheduledStatusID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseScheduledStatusShowFlags(
+		&scheduledStatusID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if scheduledStatusID == "" {
+		return missingIDError{
+			target: cli.TargetScheduledStatus,
+			action: cli.ActionShow,
+		}
+	}
+
+	var scheduledStatus model.ScheduledStatus
+	if err := client.Call(
+		"GTSClient.GetScheduledStatus",
+		scheduledStatusID,
+		&scheduledStatus,
+	); err != nil {
+		return fmt.Errorf("error retrieving the scheduled status: %w", err)
+	}
+
+	if err := printer.PrintScheduledStatus(printSettings, scheduledStatus); err != nil {
+		return fmt.Errorf("error printing the scheduled status: %w", err)
+	}
+
+	return nil
+}
+
+func scheduledStatusEdit(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		scheduledStatusID string
+		scheduledAt       = internalFlag.NewTimeValue()
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseScheduledStatusEditFlags(
+		&scheduledStatusID,
+		&scheduledAt,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if scheduledStatusID == "" {
+		return missingIDError{
+			target: cli.TargetScheduledStatus,
+			action: cli.ActionEdit,
+		}
+	}
+
+	if !scheduledAt.IsSet() {
+		return missingValueError{
+			valueType: "time",
+			target:    cli.TargetScheduledStatus,
+			action:    "reschedule",
+		}
+	}
+
+	var scheduledStatus model.ScheduledStatus
+	if err := client.Call(
+		"GTSClient.RescheduleStatus",
+		gtsclient.RescheduleStatusArgs{
+			ScheduledStatusID: scheduledStatusID,
+			ScheduledAt:       scheduledAt.Value(),
+		},
+		&scheduledStatus,
+	); err != nil {
+		return fmt.Errorf("error rescheduling the status: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully rescheduled the status with ID: "+scheduledStatus.ID)
+
+	return nil
+}
+
+func scheduledStatusDelete(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var scheduledStatusID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseScheduledStatusDeleteFlags(
+		&scheduledStatusID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if scheduledStatusID == "" {
+		return missingIDError{
+			target: cli.TargetScheduledStatus,
+			action: cli.ActionDelete,
+		}
+	}
+
+	if err := client.Call(
+		"GTSClient.DeleteScheduledStatus",
+		scheduledStatusID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error deleting the scheduled status: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "The scheduled status was successfully deleted.")
+
+	return nil
+}
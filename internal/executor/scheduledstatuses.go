@@ -0,0 +1,91 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// scheduledStatusesFunc is the function for the scheduled-statuses target
+// for viewing the list of statuses that are scheduled to be published.
+func scheduledStatusesFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return scheduledStatusesShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetScheduledStatuses}
+	}
+}
+
+func scheduledStatusesShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseScheduledStatusesShowFlags(
+		&limit,
+		&maxID,
+		&sinceID,
+		&all,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	var list model.ScheduledStatusList
+	if err := client.Call(
+		"GTSClient.GetScheduledStatuses",
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
+		&list,
+	); err != nil {
+		return fmt.Errorf("error retrieving the list of your scheduled statuses: %w", err)
+	}
+
+	if len(list.ScheduledStatuses) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("You have no scheduled statuses.\n")
+
+		return nil
+	}
+
+	if err := printer.PrintScheduledStatusList(printSettings, list); err != nil {
+		return fmt.Errorf("error printing the list of your scheduled statuses: %w", err)
+	}
+
+	return nil
+}
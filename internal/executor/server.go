@@ -2,11 +2,15 @@ package executor
 
 import (
 	"fmt"
+	"net/rpc"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/media"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/notifier"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
 )
@@ -23,6 +27,8 @@ func serverFunc(
 	switch cmd.Action {
 	case cli.ActionStart:
 		return serverStart(cfg, printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionShow:
+		return serverShow(cfg, printSettings)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetServer}
 	}
@@ -48,15 +54,64 @@ func serverStart(
 		return fmt.Errorf("unable to create the GoToSocial client: %w", err)
 	}
 
+	var serverNotifier *notifier.Notifier
+
+	if cfg.Integrations.Notifier != "" {
+		serverNotifier = notifier.New(
+			printSettings,
+			gtsClient,
+			cfg.Integrations.Notifier,
+			cfg.Server.NotifierInterval,
+		)
+	}
+
+	var mediaCacheLimiter *media.CacheLimiter
+
+	if cfg.MediaCacheMaxSize > 0 {
+		mediaCacheLimiter = media.NewCacheLimiter(cfg.CacheDirectory, cfg.MediaCacheMaxSize)
+	}
+
 	if err := server.Run(
 		printSettings,
 		gtsClient,
 		cfg.Server.SocketPath,
 		withoutIdleTimeout,
 		cfg.Server.IdleTimeout,
+		serverNotifier,
+		mediaCacheLimiter,
 	); err != nil {
 		return fmt.Errorf("error running Enbas in server mode: %w", err)
 	}
 
 	return nil
 }
+
+func serverShow(
+	cfg config.Config,
+	printSettings printer.Settings,
+) error {
+	// Create the session to interact with the running server.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	return serverShowCacheStats(session.Client(), printSettings)
+}
+
+func serverShowCacheStats(
+	client *rpc.Client,
+	printSettings printer.Settings,
+) error {
+	var stats model.MemoryCacheStats
+	if err := client.Call("GTSClient.GetMemoryCacheStats", gtsclient.NoRPCArgs{}, &stats); err != nil {
+		return fmt.Errorf("unable to retrieve the cache statistics: %w", err)
+	}
+
+	if err := printer.PrintMemoryCacheStats(printSettings, stats); err != nil {
+		return fmt.Errorf("error printing the cache statistics: %w", err)
+	}
+
+	return nil
+}
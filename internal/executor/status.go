@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"net/rpc"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
 	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/frontmatter"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
@@ -40,6 +42,7 @@ func statusFunc(
 		return statusCreate(
 			session.Client(),
 			printSettings,
+			cfg.Integrations.Editor,
 			cmd.FocusedTargetFlags,
 		)
 	case cli.ActionAdd:
@@ -68,6 +71,18 @@ func statusFunc(
 			printSettings,
 			cmd.FocusedTargetFlags,
 		)
+	case cli.ActionPin:
+		return statusPin(
+			session.Client(),
+			printSettings,
+			cmd.FocusedTargetFlags,
+		)
+	case cli.ActionUnpin:
+		return statusUnpin(
+			session.Client(),
+			printSettings,
+			cmd.FocusedTargetFlags,
+		)
 	case cli.ActionFavourite:
 		return statusFavourite(
 			session.Client(),
@@ -106,6 +121,13 @@ func statusFunc(
 			cfg.CacheDirectory,
 			cmd.FocusedTargetFlags,
 		)
+	case cli.ActionEdit:
+		return statusEdit(
+			session.Client(),
+			printSettings,
+			cfg.Integrations.Editor,
+			cmd.FocusedTargetFlags,
+		)
 	case cli.ActionFind:
 		return statusFind(
 			session.Client(),
@@ -120,11 +142,76 @@ func statusFunc(
 func statusCreate(
 	client *rpc.Client,
 	printSettings printer.Settings,
+	editor string,
 	flags []string,
 ) error {
+	newStatus, err := parseNewStatusFlags(cli.ParseStatusCreateFlags, flags)
+	if err != nil {
+		return err
+	}
+
+	// Compose the status in the user's text editor if there's no status body
+	// and no media attachments.
+	if newStatus.Content == "" && len(newStatus.AttachmentIDs) == 0 && len(newStatus.MediaFiles) == 0 {
+		newStatus, err = composeStatusInEditor(editor, newStatus)
+		if err != nil {
+			return err
+		}
+
+		if newStatus.Content == "" && len(newStatus.MediaFiles) == 0 {
+			printer.PrintInfo("The status was not created because the file was left empty.\n")
+
+			return nil
+		}
+	}
+
+	return createStatus(client, printSettings, newStatus)
+}
+
+// newStatusFlagsParser is the signature of the generated functions that parse
+// the flags for the options of a new status.
+type newStatusFlagsParser func(
+	addPoll *bool,
+	attachmentIDs *internalFlag.MultiStringValue,
+	canFavouriteAlways *internalFlag.MultiStringValue,
+	canFavouriteWithApproval *internalFlag.MultiStringValue,
+	canReblogAlways *internalFlag.MultiStringValue,
+	canReblogWithApproval *internalFlag.MultiStringValue,
+	canReplyAlways *internalFlag.MultiStringValue,
+	canReplyWithApproval *internalFlag.MultiStringValue,
+	content *string,
+	contentType *internalFlag.EnumValue,
+	inReplyTo *string,
+	language *string,
+	localOnly *bool,
+	mediaDescriptions *internalFlag.MultiStringValue,
+	mediaFiles *internalFlag.MultiStringValue,
+	mediaFocusValues *internalFlag.MultiStringValue,
+	notBoostable *bool,
+	notLikeable *bool,
+	notReplyable *bool,
+	pollAllowsMultipleChoices *bool,
+	pollExpiresIn *internalFlag.TimeDurationValue,
+	pollHidesVoteCounts *bool,
+	pollOptions *internalFlag.MultiStringValue,
+	scheduledAt *internalFlag.TimeValue,
+	sensitive *internalFlag.BoolValue,
+	summary *string,
+	visibility *internalFlag.EnumValue,
+	flags []string,
+) error
+
+// parseNewStatusFlags parses the flags for the options of a new status.
+func parseNewStatusFlags(parseFlags newStatusFlagsParser, flags []string) (model.DraftStatus, error) {
 	var (
 		addPoll                   bool
 		attachmentIDs             = internalFlag.NewMultiStringValue()
+		canFavouriteAlways        = internalFlag.NewMultiStringValue()
+		canFavouriteWithApproval  = internalFlag.NewMultiStringValue()
+		canReblogAlways           = internalFlag.NewMultiStringValue()
+		canReblogWithApproval     = internalFlag.NewMultiStringValue()
+		canReplyAlways            = internalFlag.NewMultiStringValue()
+		canReplyWithApproval      = internalFlag.NewMultiStringValue()
 		content                   string
 		contentType               internalFlag.EnumValue
 		inReplyTo                 string
@@ -140,15 +227,22 @@ func statusCreate(
 		pollExpiresIn             = internalFlag.NewTimeDurationValue(24 * time.Hour)
 		pollHidesVoteCounts       bool
 		pollOptions               = internalFlag.NewMultiStringValue()
+		scheduledAt               = internalFlag.NewTimeValue()
 		sensitive                 internalFlag.BoolValue
 		summary                   string
 		visibility                internalFlag.EnumValue
 	)
 
 	// Parse the remaining flags.
-	if err := cli.ParseStatusCreateFlags(
+	if err := parseFlags(
 		&addPoll,
 		&attachmentIDs,
+		&canFavouriteAlways,
+		&canFavouriteWithApproval,
+		&canReblogAlways,
+		&canReblogWithApproval,
+		&canReplyAlways,
+		&canReplyWithApproval,
 		&content,
 		&contentType,
 		&inReplyTo,
@@ -164,104 +258,120 @@ func statusCreate(
 		&pollExpiresIn,
 		&pollHidesVoteCounts,
 		&pollOptions,
+		&scheduledAt,
 		&sensitive,
 		&summary,
 		&visibility,
 		flags,
 	); err != nil {
-		return err //nolint:wrapcheck
+		return model.DraftStatus{}, err //nolint:wrapcheck
 	}
 
+	media, err := statusMediaFilesFromFlags(mediaFiles, mediaDescriptions, mediaFocusValues)
+	if err != nil {
+		return model.DraftStatus{}, err
+	}
+
+	newStatus := model.DraftStatus{
+		AddPoll:                   addPoll,
+		AttachmentIDs:             attachmentIDs.Values(),
+		CanFavouriteAlways:        canFavouriteAlways.Values(),
+		CanFavouriteWithApproval:  canFavouriteWithApproval.Values(),
+		CanReblogAlways:           canReblogAlways.Values(),
+		CanReblogWithApproval:     canReblogWithApproval.Values(),
+		CanReplyAlways:            canReplyAlways.Values(),
+		CanReplyWithApproval:      canReplyWithApproval.Values(),
+		Content:                   content,
+		ContentType:               contentType.Value(),
+		InReplyTo:                 inReplyTo,
+		Language:                  language,
+		LocalOnly:                 localOnly,
+		MediaFiles:                media,
+		NotBoostable:              notBoostable,
+		NotLikeable:               notLikeable,
+		NotReplyable:              notReplyable,
+		PollAllowsMultipleChoices: pollAllowsMultipleChoices,
+		PollExpiresIn:             int(pollExpiresIn.Value().Seconds()),
+		PollHidesVoteCounts:       pollHidesVoteCounts,
+		PollOptions:               pollOptions.Values(),
+		ScheduledAt:               time.Time{},
+		Sensitive:                 nil,
+		Summary:                   summary,
+		Visibility:                visibility.Value(),
+	}
+
+	if scheduledAt.IsSet() {
+		newStatus.ScheduledAt = scheduledAt.Value()
+	}
+
+	if sensitive.IsSet() {
+		value := sensitive.Value()
+		newStatus.Sensitive = &value
+	}
+
+	return newStatus, nil
+}
+
+// createStatus uploads the media files of the new status to the instance
+// and then creates (or schedules) the status.
+func createStatus(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	newStatus model.DraftStatus,
+) error {
 	// Return an error if there's no status body and no media attachments.
-	if content == "" && (attachmentIDs.Length()+mediaFiles.Length() == 0) {
+	if newStatus.Content == "" && (len(newStatus.AttachmentIDs)+len(newStatus.MediaFiles) == 0) {
 		return noContentOrMediaError{}
 	}
 
 	// Return an error if a poll is to be created with media attachments.
-	if addPoll && (attachmentIDs.Length()+mediaFiles.Length() > 0) {
+	if newStatus.AddPoll && (len(newStatus.AttachmentIDs)+len(newStatus.MediaFiles) > 0) {
 		return statusHasPollAndMediaError{}
 	}
 
-	allAttachmentIDs := attachmentIDs.Values()
+	if newStatus.AddPoll && len(newStatus.PollOptions) == 0 {
+		return noPollOptionsError{}
+	}
 
-	if !mediaFiles.Empty() {
-		var (
-			descriptionsPresent = false
-			focusValuesPresent  = false
-		)
+	interactionPolicy, err := newStatusInteractionPolicy(newStatus)
+	if err != nil {
+		return err
+	}
 
-		if !mediaDescriptions.Empty() {
-			if !mediaDescriptions.ExpectedLength(mediaFiles.Length()) {
-				return mismatchedMediaFlagsError{
-					kind: "descriptions",
-					want: mediaFiles.Length(),
-					got:  mediaDescriptions.Length(),
-				}
-			}
+	allAttachmentIDs := slices.Clone(newStatus.AttachmentIDs)
 
-			descriptionsPresent = true
+	for _, mediaFile := range newStatus.MediaFiles {
+		description, err := utilities.ReadContents(mediaFile.Description)
+		if err != nil {
+			return fmt.Errorf(
+				"error reading the contents from %s: %w",
+				mediaFile.Description,
+				err,
+			)
 		}
 
-		if !mediaFocusValues.Empty() {
-			if !mediaFocusValues.ExpectedLength(mediaFiles.Length()) {
-				return mismatchedMediaFlagsError{
-					kind: "focus values",
-					want: mediaFiles.Length(),
-					got:  mediaFocusValues.Length(),
-				}
-			}
-
-			focusValuesPresent = true
+		var attachment model.MediaAttachment
+		if err := client.Call(
+			"GTSClient.CreateMediaAttachment",
+			gtsclient.CreateMediaAttachmentArgs{
+				Path:        mediaFile.Path,
+				Description: description,
+				Focus:       mediaFile.Focus,
+			},
+			&attachment,
+		); err != nil {
+			return fmt.Errorf("error creating the media attachment for %s: %w", mediaFile.Path, err)
 		}
 
-		for idx := range mediaFiles.Length() {
-			var (
-				mediaFile   string
-				description string
-				focus       string
-				attachment  model.MediaAttachment
-				err         error
-			)
-
-			mediaFile = mediaFiles.Values()[idx]
-
-			if descriptionsPresent {
-				description, err = utilities.ReadContents(mediaDescriptions.Values()[idx])
-				if err != nil {
-					return fmt.Errorf(
-						"error reading the contents from %s: %w",
-						mediaDescriptions.Values()[idx],
-						err,
-					)
-				}
-			}
-
-			if focusValuesPresent {
-				focus = mediaFocusValues.Values()[idx]
-			}
-
-			if err = client.Call(
-				"GTSClient.CreateMediaAttachment",
-				gtsclient.CreateMediaAttachmentArgs{
-					Path:        mediaFile,
-					Description: description,
-					Focus:       focus,
-				},
-				&attachment,
-			); err != nil {
-				return fmt.Errorf("error creating the media attachment for %s: %w", mediaFile, err)
-			}
-
-			printer.PrintSuccess(
-				printSettings,
-				"Successfully created the media attachment with ID: "+attachment.ID,
-			)
+		printer.PrintSuccess(
+			printSettings,
+			"Successfully created the media attachment with ID: "+attachment.ID,
+		)
 
-			allAttachmentIDs = append(allAttachmentIDs, attachment.ID)
-		}
+		allAttachmentIDs = append(allAttachmentIDs, attachment.ID)
 	}
 
-	content, err := utilities.ReadContents(content)
+	content, err := utilities.ReadContents(newStatus.Content)
 	if err != nil {
 		return fmt.Errorf("unable to read the content for the status: %w", err)
 	}
@@ -275,57 +385,79 @@ func statusCreate(
 		printer.PrintInfo("WARNING: Unable to get your posting preferences: " + err.Error() + ".\n")
 	}
 
+	language := newStatus.Language
 	if language == "" {
 		language = preferences.PostingDefaultLanguage
 	}
 
-	statusVisibility := visibility.Value()
-	if statusVisibility == "" {
-		statusVisibility = preferences.PostingDefaultVisibility
+	visibility := newStatus.Visibility
+	if visibility == "" {
+		visibility = preferences.PostingDefaultVisibility
 	}
 
-	var statusSensitive bool
-	if sensitive.IsSet() {
-		statusSensitive = sensitive.Value()
-	} else {
-		statusSensitive = preferences.PostingDefaultSensitive
+	sensitive := preferences.PostingDefaultSensitive
+	if newStatus.Sensitive != nil {
+		sensitive = *newStatus.Sensitive
+	}
+
+	contentType := newStatus.ContentType
+	if contentType == "" {
+		contentType = "plain"
 	}
 
 	form := gtsclient.CreateStatusForm{
-		Content:       content,
-		ContentType:   "text/" + contentType.Value(),
-		Language:      language,
-		SpoilerText:   summary,
-		Boostable:     !notBoostable,
-		LocalOnly:     localOnly,
-		InReplyTo:     inReplyTo,
-		Likeable:      !notLikeable,
-		Replyable:     !notReplyable,
-		Sensitive:     statusSensitive,
-		Visibility:    statusVisibility,
-		Poll:          nil,
-		AttachmentIDs: nil,
+		Content:           content,
+		ContentType:       "text/" + contentType,
+		Language:          language,
+		SpoilerText:       newStatus.Summary,
+		Boostable:         !newStatus.NotBoostable,
+		LocalOnly:         newStatus.LocalOnly,
+		InReplyTo:         newStatus.InReplyTo,
+		Likeable:          !newStatus.NotLikeable,
+		Replyable:         !newStatus.NotReplyable,
+		Sensitive:         sensitive,
+		Visibility:        visibility,
+		Poll:              nil,
+		AttachmentIDs:     nil,
+		ScheduledAt:       "",
+		InteractionPolicy: interactionPolicy,
 	}
 
 	if len(allAttachmentIDs) > 0 {
 		form.AttachmentIDs = allAttachmentIDs
 	}
 
-	if addPoll {
-		if pollOptions.Length() == 0 {
-			return noPollOptionsError{}
-		}
-
+	if newStatus.AddPoll {
 		poll := gtsclient.CreateStatusPollForm{
-			Options:    pollOptions.Values(),
-			Multiple:   pollAllowsMultipleChoices,
-			HideTotals: pollHidesVoteCounts,
-			ExpiresIn:  int(pollExpiresIn.Value().Seconds()),
+			Options:    newStatus.PollOptions,
+			Multiple:   newStatus.PollAllowsMultipleChoices,
+			HideTotals: newStatus.PollHidesVoteCounts,
+			ExpiresIn:  newStatus.PollExpiresIn,
 		}
 
 		form.Poll = &poll
 	}
 
+	if !newStatus.ScheduledAt.IsZero() {
+		form.ScheduledAt = newStatus.ScheduledAt.UTC().Format(time.RFC3339)
+
+		var scheduledStatus model.ScheduledStatus
+		if err := client.Call(
+			"GTSClient.CreateScheduledStatus",
+			form,
+			&scheduledStatus,
+		); err != nil {
+			return fmt.Errorf("error scheduling the status: %w", err)
+		}
+
+		printer.PrintSuccess(
+			printSettings,
+			"Successfully scheduled the status with ID: "+scheduledStatus.ID,
+		)
+
+		return nil
+	}
+
 	var status model.Status
 	if err := client.Call(
 		"GTSClient.CreateStatus",
@@ -340,6 +472,155 @@ func statusCreate(
 	return nil
 }
 
+// statusMediaFilesFromFlags pairs the media files with their descriptions
+// and focus values from the command line flags.
+func statusMediaFilesFromFlags(
+	mediaFiles internalFlag.MultiStringValue,
+	mediaDescriptions internalFlag.MultiStringValue,
+	mediaFocusValues internalFlag.MultiStringValue,
+) ([]model.DraftMediaFile, error) {
+	if mediaFiles.Empty() {
+		return nil, nil
+	}
+
+	if !mediaDescriptions.Empty() && !mediaDescriptions.ExpectedLength(mediaFiles.Length()) {
+		return nil, mismatchedMediaFlagsError{
+			kind: "descriptions",
+			want: mediaFiles.Length(),
+			got:  mediaDescriptions.Length(),
+		}
+	}
+
+	if !mediaFocusValues.Empty() && !mediaFocusValues.ExpectedLength(mediaFiles.Length()) {
+		return nil, mismatchedMediaFlagsError{
+			kind: "focus values",
+			want: mediaFiles.Length(),
+			got:  mediaFocusValues.Length(),
+		}
+	}
+
+	media := make([]model.DraftMediaFile, mediaFiles.Length())
+
+	for idx := range mediaFiles.Length() {
+		media[idx] = model.DraftMediaFile{
+			Path:        mediaFiles.Values()[idx],
+			Description: "",
+			Focus:       "",
+		}
+
+		if !mediaDescriptions.Empty() {
+			media[idx].Description = mediaDescriptions.Values()[idx]
+		}
+
+		if !mediaFocusValues.Empty() {
+			media[idx].Focus = mediaFocusValues.Values()[idx]
+		}
+	}
+
+	return media, nil
+}
+
+// composeStatusInEditor opens the user's text editor for composing the new status.
+// The options of the new status are placed in the front matter header above the
+// body of the status and are updated from the edited header.
+func composeStatusInEditor(editor string, newStatus model.DraftStatus) (model.DraftStatus, error) {
+	var header frontmatter.Header
+
+	header.AddComment("Write your status after the closing '---' line. Leave the file empty to cancel.")
+	header.AddComment("Leave the visibility, language or sensitive fields empty to use your posting preferences.")
+	header.Set("visibility", newStatus.Visibility)
+	header.Set("summary", newStatus.Summary)
+	header.Set("language", newStatus.Language)
+
+	if newStatus.Sensitive != nil {
+		header.SetBool("sensitive", *newStatus.Sensitive)
+	} else {
+		header.Set("sensitive", "")
+	}
+
+	header.Set("in-reply-to", newStatus.InReplyTo)
+
+	media := make([]frontmatter.Object, len(newStatus.MediaFiles))
+	for idx := range newStatus.MediaFiles {
+		media[idx] = frontmatter.Object{
+			{Key: "file", Value: newStatus.MediaFiles[idx].Path},
+			{Key: "description", Value: newStatus.MediaFiles[idx].Description},
+			{Key: "focus", Value: newStatus.MediaFiles[idx].Focus},
+		}
+	}
+
+	header.AddComment("Add media files as a list of objects with the file, description and focus fields.")
+	header.SetObjects("media", media)
+	header.AddComment("Add a poll to the status with a list of poll options.")
+	header.SetList("poll-options", newStatus.PollOptions)
+
+	document := frontmatter.Format(header, newStatus.Content)
+
+	edited, err := utilities.EditText(editor, document)
+	if err != nil {
+		return model.DraftStatus{}, fmt.Errorf("unable to compose the status: %w", err)
+	}
+
+	editedHeader, body, err := frontmatter.Parse(edited)
+	if err != nil {
+		return model.DraftStatus{}, fmt.Errorf("unable to parse the composed status: %w", err)
+	}
+
+	// Validate the visibility against the values accepted by the command line flag.
+	visibility := editedHeader.Get("visibility")
+	if visibility != "" {
+		visibilityValue := internalFlag.NewEnumValue(
+			[]string{"public", "private", "unlisted", "mutuals_only", "direct"},
+			"",
+		)
+
+		if err := visibilityValue.Set(visibility); err != nil {
+			return model.DraftStatus{}, fmt.Errorf("invalid visibility %q: %w", visibility, err)
+		}
+	}
+
+	sensitiveValue, isSet, err := editedHeader.Bool("sensitive")
+	if err != nil {
+		return model.DraftStatus{}, fmt.Errorf("unable to parse the sensitive field: %w", err)
+	}
+
+	var sensitive *bool
+	if isSet {
+		sensitive = &sensitiveValue
+	}
+
+	mediaObjects, err := editedHeader.Objects("media")
+	if err != nil {
+		return model.DraftStatus{}, fmt.Errorf("unable to parse the media files: %w", err)
+	}
+
+	composedMedia := make([]model.DraftMediaFile, 0, len(mediaObjects))
+
+	for idx := range mediaObjects {
+		if mediaObjects[idx].Get("file") == "" {
+			return model.DraftStatus{}, missingMediaFileError{}
+		}
+
+		composedMedia = append(composedMedia, model.DraftMediaFile{
+			Path:        mediaObjects[idx].Get("file"),
+			Description: mediaObjects[idx].Get("description"),
+			Focus:       mediaObjects[idx].Get("focus"),
+		})
+	}
+
+	newStatus.Content = body
+	newStatus.Visibility = visibility
+	newStatus.Summary = editedHeader.Get("summary")
+	newStatus.Language = editedHeader.Get("language")
+	newStatus.Sensitive = sensitive
+	newStatus.InReplyTo = editedHeader.Get("in-reply-to")
+	newStatus.MediaFiles = composedMedia
+	newStatus.PollOptions = editedHeader.List("poll-options")
+	newStatus.AddPoll = newStatus.AddPoll || len(newStatus.PollOptions) > 0
+
+	return newStatus, nil
+}
+
 func statusAdd(
 	client *rpc.Client,
 	printSettings printer.Settings,
@@ -582,6 +863,120 @@ func statusUnmute(
 	return nil
 }
 
+func statusPin(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var statusID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseStatusPinFlags(
+		&statusID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if statusID == "" {
+		return missingIDError{
+			target: cli.TargetStatus,
+			action: cli.ActionPin,
+		}
+	}
+
+	var status model.Status
+	if err := client.Call(
+		"GTSClient.GetStatus",
+		statusID,
+		&status,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve the status: %w", err)
+	}
+
+	var myAccountID string
+	if err := client.Call(
+		"GTSClient.GetMyAccountID",
+		gtsclient.NoRPCArgs{},
+		&myAccountID,
+	); err != nil {
+		return fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	if status.Account.ID != myAccountID {
+		return forbiddenActionOnStatusError{action: cli.ActionPin, includeNotMentioned: false}
+	}
+
+	if err := client.Call(
+		"GTSClient.PinStatus",
+		statusID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error pinning the status: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully pinned the status.")
+
+	return nil
+}
+
+func statusUnpin(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var statusID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseStatusUnpinFlags(
+		&statusID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if statusID == "" {
+		return missingIDError{
+			target: cli.TargetStatus,
+			action: cli.ActionUnpin,
+		}
+	}
+
+	var status model.Status
+	if err := client.Call(
+		"GTSClient.GetStatus",
+		statusID,
+		&status,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve the status: %w", err)
+	}
+
+	var myAccountID string
+	if err := client.Call(
+		"GTSClient.GetMyAccountID",
+		gtsclient.NoRPCArgs{},
+		&myAccountID,
+	); err != nil {
+		return fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	if status.Account.ID != myAccountID {
+		return forbiddenActionOnStatusError{action: cli.ActionUnpin, includeNotMentioned: false}
+	}
+
+	if err := client.Call(
+		"GTSClient.UnpinStatus",
+		statusID,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error unpinning the status: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully unpinned the status.")
+
+	return nil
+}
+
 func statusFavourite(
 	client *rpc.Client,
 	printSettings printer.Settings,
@@ -824,19 +1219,33 @@ func statusDelete(
 	flags []string,
 ) error {
 	var (
-		statusID string
-		saveText bool
+		statusID  string
+		saveText  bool
+		fromStdin bool
 	)
 
 	// Parse the remaining flags.
 	if err := cli.ParseStatusDeleteFlags(
 		&statusID,
 		&saveText,
+		&fromStdin,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	if fromStdin {
+		return bulkOperation{
+			action: cli.ActionDelete,
+			target: cli.TargetStatus,
+			flag:   "status-id",
+			flags:  flags,
+			run: func(itemSettings printer.Settings, itemFlags []string) error {
+				return statusDelete(client, itemSettings, cacheRoot, itemFlags)
+			},
+		}.execute(printSettings)
+	}
+
 	if statusID == "" {
 		return missingIDError{
 			target: cli.TargetStatus,
@@ -904,6 +1313,235 @@ func statusDelete(
 	return nil
 }
 
+func statusEdit(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	editor string,
+	flags []string,
+) error {
+	var statusID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseStatusEditFlags(
+		&statusID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if statusID == "" {
+		return missingIDError{
+			target: cli.TargetStatus,
+			action: cli.ActionEdit,
+		}
+	}
+
+	var status model.Status
+	if err := client.Call(
+		"GTSClient.GetStatus",
+		statusID,
+		&status,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve the status: %w", err)
+	}
+
+	var myAccountID string
+	if err := client.Call(
+		"GTSClient.GetMyAccountID",
+		gtsclient.NoRPCArgs{},
+		&myAccountID,
+	); err != nil {
+		return fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	if status.Account.ID != myAccountID {
+		return forbiddenActionOnStatusError{action: cli.ActionEdit, includeNotMentioned: false}
+	}
+
+	var source model.StatusSource
+	if err := client.Call(
+		"GTSClient.GetStatusSource",
+		statusID,
+		&source,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve the source of the status: %w", err)
+	}
+
+	document := statusEditDocument(status, source)
+
+	edited, err := utilities.EditText(editor, document)
+	if err != nil {
+		return fmt.Errorf("unable to edit the status: %w", err)
+	}
+
+	if edited == document {
+		printer.PrintInfo("The status was not updated because no changes were made.\n")
+
+		return nil
+	}
+
+	header, body, err := frontmatter.Parse(edited)
+	if err != nil {
+		return fmt.Errorf("unable to parse the edited status: %w", err)
+	}
+
+	if body == "" && header.Get("summary") == "" && len(header.List("media")) == 0 {
+		printer.PrintInfo("The status was not updated because the file was left empty.\n")
+
+		return nil
+	}
+
+	form, err := statusEditForm(status, header, body)
+	if err != nil {
+		return err
+	}
+
+	var updated model.Status
+	if err := client.Call(
+		"GTSClient.EditStatus",
+		gtsclient.EditStatusArgs{
+			StatusID: statusID,
+			Form:     form,
+		},
+		&updated,
+	); err != nil {
+		return fmt.Errorf("error editing the status: %w", err)
+	}
+
+	printer.PrintSuccess(printSettings, "Successfully edited the status.")
+
+	return nil
+}
+
+// statusEditDocument creates the document that is opened in the user's text editor
+// when editing the status. The editable fields are placed in the header and the
+// source text of the status is placed in the body.
+func statusEditDocument(status model.Status, source model.StatusSource) string {
+	var header frontmatter.Header
+
+	header.AddComment("Edit the fields below and the content of the status after the closing '---' line.")
+	header.Set("summary", source.SpoilerText)
+	header.SetBool("sensitive", status.Sensitive)
+
+	if len(status.MediaAttachments) > 0 {
+		header.AddComment("Remove an item from the media list to remove the media attachment from the status.")
+
+		media := make([]frontmatter.Object, len(status.MediaAttachments))
+
+		for idx := range status.MediaAttachments {
+			media[idx] = frontmatter.Object{
+				{Key: "id", Value: status.MediaAttachments[idx].ID},
+				{Key: "description", Value: status.MediaAttachments[idx].Description},
+			}
+		}
+
+		header.SetObjects("media", media)
+	}
+
+	if status.Poll.ID != "" {
+		options := make([]string, len(status.Poll.Options))
+
+		for idx := range status.Poll.Options {
+			options[idx] = status.Poll.Options[idx].Title
+		}
+
+		header.AddComment("Changing the poll options will reset the votes.")
+		header.SetList("poll-options", options)
+	}
+
+	return frontmatter.Format(header, source.Text)
+}
+
+// statusEditForm creates the form for editing the status from the
+// header and body of the edited document.
+func statusEditForm(status model.Status, header frontmatter.Header, body string) (gtsclient.EditStatusForm, error) {
+	sensitive, isSet, err := header.Bool("sensitive")
+	if err != nil {
+		return gtsclient.EditStatusForm{}, fmt.Errorf("unable to parse the sensitive field: %w", err)
+	}
+
+	if !isSet {
+		sensitive = status.Sensitive
+	}
+
+	media, err := header.Objects("media")
+	if err != nil {
+		return gtsclient.EditStatusForm{}, fmt.Errorf("unable to parse the media attachments: %w", err)
+	}
+
+	form := gtsclient.EditStatusForm{
+		Content:         body,
+		SpoilerText:     header.Get("summary"),
+		Sensitive:       sensitive,
+		Language:        status.Language,
+		ContentType:     status.ContentType,
+		AttachmentIDs:   make([]string, len(media)),
+		MediaAttributes: make([]gtsclient.EditStatusMediaAttribute, len(media)),
+		Poll:            nil,
+	}
+
+	for idx := range media {
+		form.AttachmentIDs[idx] = media[idx].Get("id")
+		form.MediaAttributes[idx] = gtsclient.EditStatusMediaAttribute{
+			ID:          media[idx].Get("id"),
+			Description: media[idx].Get("description"),
+			Focus:       "",
+		}
+	}
+
+	// The poll is removed from the status if it is left out of the edit
+	// so it is only left out if the poll-options field was removed.
+	options := header.List("poll-options")
+	if len(options) == 0 {
+		return form, nil
+	}
+
+	// A new poll is open for 24 hours. An existing poll is always sent back
+	// with its remaining time so that the instance keeps it (and its votes)
+	// when the options are unchanged. The options of a closed poll cannot
+	// be changed.
+	expiresIn := 24 * time.Hour
+
+	if status.Poll.ID != "" {
+		closed := status.Poll.Expired ||
+			(!status.Poll.ExpiredAt.IsZero() && !status.Poll.ExpiredAt.After(time.Now()))
+
+		switch {
+		case closed && pollOptionsChanged(status.Poll, options):
+			return gtsclient.EditStatusForm{}, pollClosedError{}
+		case closed, status.Poll.ExpiredAt.IsZero():
+			expiresIn = 0
+		default:
+			expiresIn = time.Until(status.Poll.ExpiredAt)
+		}
+	}
+
+	form.Poll = &gtsclient.EditStatusPollForm{
+		Options:    options,
+		ExpiresIn:  int(expiresIn.Seconds()),
+		Multiple:   status.Poll.Multiple,
+		HideTotals: nil,
+	}
+
+	return form, nil
+}
+
+// pollOptionsChanged returns true if the edited poll options are different
+// from the options of the status' current poll.
+func pollOptionsChanged(poll model.Poll, options []string) bool {
+	if poll.ID == "" || len(poll.Options) != len(options) {
+		return true
+	}
+
+	for idx := range poll.Options {
+		if poll.Options[idx].Title != options[idx] {
+			return true
+		}
+	}
+
+	return false
+}
+
 func statusFind(
 	client *rpc.Client,
 	printSettings printer.Settings,
@@ -0,0 +1,434 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/archive"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/media"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+const (
+	// exportPageLimit is the number of statuses retrieved per page
+	// when exporting an account's statuses.
+	exportPageLimit int = 40
+
+	// pruneDeleteInterval is the time between the deletion of each status when
+	// pruning your statuses. This keeps the deletions within GoToSocial's default
+	// rate limit of 300 requests every 5 minutes.
+	pruneDeleteInterval = time.Second
+)
+
+// statusesFunc is the function for the statuses target for managing
+// the statuses posted by an account.
+func statusesFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionExport:
+		return statusesExport(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionPrune:
+		return statusesPrune(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetStatuses}
+	}
+}
+
+func statusesExport(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		accountName string
+		myAccount   bool
+		outputDir   string
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseStatusesExportFlags(
+		&accountName,
+		&myAccount,
+		&outputDir,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if outputDir == "" {
+		return missingOutputDirectoryError{}
+	}
+
+	// The media attachments are downloaded by the daemon process
+	// so the absolute path of the archive is required.
+	archiveDir, err := utilities.AbsolutePath(outputDir)
+	if err != nil {
+		return fmt.Errorf("unable to calculate the path to the archive: %w", err)
+	}
+
+	var account model.Account
+
+	if myAccount {
+		if err := client.Call("GTSClient.GetMyAccount", gtsclient.NoRPCArgs{}, &account); err != nil {
+			return fmt.Errorf("unable to retrieve your account: %w", err)
+		}
+	} else {
+		if accountName == "" {
+			return missingValueError{
+				valueType: "name",
+				target:    cli.TargetAccount,
+				action:    cli.ActionExport,
+			}
+		}
+
+		if err := client.Call("GTSClient.GetAccount", accountName, &account); err != nil {
+			return fmt.Errorf("unable to get the account information: %w", err)
+		}
+	}
+
+	if err := utilities.EnsureDirectory(archive.MediaDir(archiveDir)); err != nil {
+		return fmt.Errorf("unable to ensure the existence of the media directory: %w", err)
+	}
+
+	index := model.ArchiveIndex{
+		Account:    account,
+		ExportedAt: time.Now().UTC(),
+		Statuses:   make([]model.ArchiveEntry, 0),
+	}
+
+	maxID := ""
+
+	// Walk through the account's statuses one page at a time,
+	// starting with the most recent status.
+	for {
+		var statusList model.StatusList
+		if err := client.Call(
+			"GTSClient.GetAccountStatuses",
+			gtsclient.GetAccountStatusesArgs{
+				AccountID: account.ID,
+				Pagination: gtsclient.PaginationArgs{
+					Limit:   exportPageLimit,
+					MaxID:   maxID,
+					MinID:   "",
+					SinceID: "",
+					All:     false,
+				},
+				ExcludeReplies: false,
+				ExcludeReblogs: false,
+				Pinned:         false,
+				OnlyMedia:      false,
+				OnlyPublic:     false,
+			},
+			&statusList,
+		); err != nil {
+			return fmt.Errorf("unable to retrieve the account's statuses: %w", err)
+		}
+
+		for idx := range statusList.Statuses {
+			entry, err := exportStatus(client, archiveDir, statusList.Statuses[idx])
+			if err != nil {
+				return err
+			}
+
+			index.Statuses = append(index.Statuses, entry)
+		}
+
+		if len(statusList.Statuses) == 0 || !statusList.Pagination.HasNextPage() {
+			break
+		}
+
+		maxID = statusList.Pagination.NextMaxID
+
+		if printSettings.TextOutput() {
+			printer.PrintInfo("Exported " + strconv.Itoa(len(index.Statuses)) + " statuses so far...\n")
+		}
+	}
+
+	if err := archive.SaveIndex(archiveDir, index); err != nil {
+		return fmt.Errorf("unable to save the index of the archive: %w", err)
+	}
+
+	printer.PrintSuccess(
+		printSettings,
+		"Successfully exported "+strconv.Itoa(len(index.Statuses))+" statuses to "+archiveDir+".",
+	)
+
+	return nil
+}
+
+// exportStatus saves the status and its media attachments to the archive. The media
+// attachments of the boosted status are saved if the status is a boost.
+func exportStatus(client *rpc.Client, archiveDir string, status model.Status) (model.ArchiveEntry, error) {
+	statusFile, err := archive.SaveStatus(archiveDir, status)
+	if err != nil {
+		return model.ArchiveEntry{}, fmt.Errorf("unable to save the status %s to the archive: %w", status.ID, err)
+	}
+
+	isReblog := status.Reblog.ID != ""
+
+	attachments := status.MediaAttachments
+	if isReblog {
+		attachments = status.Reblog.MediaAttachments
+	}
+
+	downloaded, err := media.DownloadAttachments(client, archive.MediaDir(archiveDir), attachments)
+	if err != nil {
+		return model.ArchiveEntry{}, fmt.Errorf(
+			"unable to save the media attachments of the status %s to the archive: %w",
+			status.ID,
+			err,
+		)
+	}
+
+	mediaFiles := make([]string, len(downloaded))
+
+	for idx := range downloaded {
+		mediaFiles[idx], err = archive.RelativePath(archiveDir, downloaded[idx])
+		if err != nil {
+			return model.ArchiveEntry{}, err
+		}
+	}
+
+	entry := model.ArchiveEntry{
+		ID:         status.ID,
+		CreatedAt:  status.CreatedAt,
+		URL:        status.URL,
+		Reblog:     isReblog,
+		Reply:      status.InReplyToID != "",
+		StatusFile: statusFile,
+		MediaFiles: mediaFiles,
+	}
+
+	return entry, nil
+}
+
+// statusPrunePolicy is the policy for deciding which of your statuses are deleted
+// when pruning your statuses.
+type statusPrunePolicy struct {
+	cutoff         time.Time
+	keepPinned     bool
+	keepBookmarked bool
+
+	// keepLikesOver and keepBoostsOver are negative if the statuses are
+	// not kept based on their number of likes or boosts.
+	keepLikesOver  int
+	keepBoostsOver int
+	keepVisibility []string
+	keepTags       []string
+}
+
+// prune returns true if the status should be deleted.
+func (p statusPrunePolicy) prune(status model.Status) bool {
+	if !status.CreatedAt.Before(p.cutoff) {
+		return false
+	}
+
+	if (p.keepPinned && status.Pinned) || (p.keepBookmarked && status.Bookmarked) {
+		return false
+	}
+
+	if (p.keepLikesOver >= 0 && status.FavouritesCount > p.keepLikesOver) ||
+		(p.keepBoostsOver >= 0 && status.ReblogsCount > p.keepBoostsOver) {
+		return false
+	}
+
+	if slices.Contains(p.keepVisibility, status.Visibility) {
+		return false
+	}
+
+	for _, tag := range status.Tags {
+		if slices.ContainsFunc(p.keepTags, func(keep string) bool {
+			return strings.EqualFold(strings.TrimPrefix(keep, "#"), tag.Name)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func statusesPrune(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		olderThan      = internalFlag.NewTimeDurationValue(time.Duration(0))
+		keepPinned     bool
+		keepBookmarked bool
+		keepLikesOver  int
+		keepBoostsOver int
+		keepVisibility internalFlag.MultiEnumValue
+		keepTags       = internalFlag.NewMultiStringValue()
+		confirmed      bool
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseStatusesPruneFlags(
+		&olderThan,
+		&keepPinned,
+		&keepBookmarked,
+		&keepLikesOver,
+		&keepBoostsOver,
+		&keepVisibility,
+		&keepTags,
+		&confirmed,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if olderThan.Value() <= 0 {
+		return missingValueError{
+			valueType: "minimum age",
+			target:    cli.TargetStatuses,
+			action:    cli.ActionPrune,
+		}
+	}
+
+	if !confirmed && !stdinIsTerminal() {
+		return confirmationRequiredError{action: cli.ActionPrune}
+	}
+
+	policy := statusPrunePolicy{
+		cutoff:         time.Now().Add(-olderThan.Value()),
+		keepPinned:     keepPinned,
+		keepBookmarked: keepBookmarked,
+		keepLikesOver:  keepLikesOver,
+		keepBoostsOver: keepBoostsOver,
+		keepVisibility: keepVisibility.Values(),
+		keepTags:       keepTags.Values(),
+	}
+
+	var myAccountID string
+	if err := client.Call(
+		"GTSClient.GetMyAccountID",
+		gtsclient.NoRPCArgs{},
+		&myAccountID,
+	); err != nil {
+		return fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	var statusList model.StatusList
+	if err := client.Call(
+		"GTSClient.GetAccountStatuses",
+		gtsclient.GetAccountStatusesArgs{
+			AccountID: myAccountID,
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   exportPageLimit,
+				MaxID:   "",
+				MinID:   "",
+				SinceID: "",
+				All:     true,
+			},
+			ExcludeReplies: false,
+			ExcludeReblogs: true,
+			Pinned:         false,
+			OnlyMedia:      false,
+			OnlyPublic:     false,
+		},
+		&statusList,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve your statuses: %w", err)
+	}
+
+	toDelete := model.StatusList{
+		Name:       "STATUSES TO DELETE:",
+		Statuses:   make([]model.Status, 0),
+		Pagination: model.Pagination{},
+	}
+
+	for idx := range statusList.Statuses {
+		if policy.prune(statusList.Statuses[idx]) {
+			toDelete.Statuses = append(toDelete.Statuses, statusList.Statuses[idx])
+		}
+	}
+
+	if len(toDelete.Statuses) == 0 {
+		printer.PrintSuccess(printSettings, "There are no statuses to delete.")
+
+		return nil
+	}
+
+	if err := printer.PrintStatusList(printSettings, toDelete, myAccountID); err != nil {
+		return fmt.Errorf("error printing the statuses to delete: %w", err)
+	}
+
+	if !confirmed {
+		answer, err := confirm("Delete " + strconv.Itoa(len(toDelete.Statuses)) + " statuses?")
+		if err != nil {
+			return err
+		}
+
+		if !answer {
+			printer.PrintPrompt("No statuses were deleted.\n")
+
+			return nil
+		}
+	}
+
+	results := make([]model.BulkResult, len(toDelete.Statuses))
+
+	ticker := time.NewTicker(pruneDeleteInterval)
+	defer ticker.Stop()
+
+	for idx, status := range toDelete.Statuses {
+		if idx > 0 {
+			<-ticker.C
+		}
+
+		results[idx] = model.BulkResult{
+			Item:      status.ID,
+			Succeeded: true,
+			Error:     "",
+		}
+
+		var text string
+		if err := client.Call("GTSClient.DeleteStatus", status.ID, &text); err != nil {
+			results[idx].Succeeded = false
+			results[idx].Error = err.Error()
+		}
+	}
+
+	report := model.BulkReport{
+		Action:  cli.ActionDelete,
+		Target:  cli.TargetStatus,
+		Results: results,
+	}
+
+	if err := printer.PrintBulkReport(printSettings, report); err != nil {
+		return fmt.Errorf("error printing the results: %w", err)
+	}
+
+	if failed := report.Failed(); failed > 0 {
+		return bulkOperationFailedError{failed: failed, total: len(results)}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+)
+
+// statusHistoryFunc is the function for the status-history target for
+// viewing the previous revisions of a status.
+func statusHistoryFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionShow:
+		return statusHistoryShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetStatusHistory}
+	}
+}
+
+func statusHistoryShow(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var statusID string
+
+	// Parse the remaining flags.
+	if err := cli.ParseStatusHistoryShowFlags(
+		&statusID,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	if statusID == "" {
+		return missingIDError{
+			target: cli.TargetStatus,
+			action: "view the edit history of",
+		}
+	}
+
+	var history model.StatusHistory
+	if err := client.Call(
+		"GTSClient.GetStatusHistory",
+		statusID,
+		&history,
+	); err != nil {
+		return fmt.Errorf("error retrieving the edit history of the status: %w", err)
+	}
+
+	if len(history.Edits) == 0 && printSettings.TextOutput() {
+		printer.PrintInfo("There is no edit history for this status.\n")
+
+		return nil
+	}
+
+	if err := printer.PrintStatusHistory(printSettings, history); err != nil {
+		return fmt.Errorf("error printing the edit history of the status: %w", err)
+	}
+
+	return nil
+}
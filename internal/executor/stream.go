@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"net/rpc"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+)
+
+// watchStream opens the stream on the server and prints the events as they
+// arrive until the user sends the interrupt signal.
+func watchStream(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	args gtsclient.OpenStreamArgs,
+) error {
+	var myAccountID string
+	if err := client.Call("GTSClient.GetMyAccountID", gtsclient.NoRPCArgs{}, &myAccountID); err != nil {
+		return fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	var streamID string
+	if err := client.Call("GTSClient.OpenStream", args, &streamID); err != nil {
+		return fmt.Errorf("unable to open the stream: %w", err)
+	}
+
+	defer client.Call("GTSClient.CloseStream", streamID, nil) //nolint:errcheck
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+	)
+	defer stop()
+
+	for {
+		var events []model.StreamEvent
+
+		call := client.Go("GTSClient.GetStreamEvents", streamID, &events, nil)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-call.Done:
+		}
+
+		if call.Error != nil {
+			return fmt.Errorf("error receiving the events from the stream: %w", call.Error)
+		}
+
+		for idx := range events {
+			if err := printer.PrintStreamEvent(printSettings, events[idx], myAccountID); err != nil {
+				return fmt.Errorf("error printing the event from the stream: %w", err)
+			}
+		}
+	}
+}
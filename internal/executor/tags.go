@@ -7,6 +7,7 @@ import (
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
@@ -41,11 +42,19 @@ func tagsShow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var limit int
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
 
 	// Parse the remaining flags.
 	if err := cli.ParseTagsShowFlags(
 		&limit,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
@@ -54,13 +63,19 @@ func tagsShow(
 	var list model.TagList
 	if err := client.Call(
 		"GTSClient.GetFollowedTags",
-		limit,
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
 		&list,
 	); err != nil {
 		return fmt.Errorf("error retrieving the list of followed tags: %w", err)
 	}
 
-	if len(list.Tags) > 0 {
+	if len(list.Tags) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintTagList(printSettings, list); err != nil {
 			return fmt.Errorf("error printing the list of followed tags: %w", err)
 		}
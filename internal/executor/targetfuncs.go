@@ -11,42 +11,59 @@ import "codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 // associated targetFunc.
 func targetFuncMap() map[string]targetFunc {
 	return map[string]targetFunc{
-		cli.TargetAccess:          accessFunc,
-		cli.TargetAccount:         accountFunc,
-		cli.TargetAccounts:        accountsFunc,
-		cli.TargetAlias:           aliasFunc,
-		cli.TargetAliases:         aliasesFunc,
-		cli.TargetBlockedAccounts: blockedAccountsFunc,
-		cli.TargetBookmarks:       bookmarksFunc,
-		cli.TargetConfig:          configFunc,
-		cli.TargetFavourites:      favouritesFunc,
-		cli.TargetFilter:          filterFunc,
-		cli.TargetFilterKeyword:   filterKeywordFunc,
-		cli.TargetFilterStatus:    filterStatusFunc,
-		cli.TargetFilters:         filtersFunc,
-		cli.TargetFollowRequest:   followRequestFunc,
-		cli.TargetFollowRequests:  followRequestsFunc,
-		cli.TargetFollowers:       followersFunc,
-		cli.TargetFollowings:      followingsFunc,
-		cli.TargetInstance:        instanceFunc,
-		cli.TargetList:            listFunc,
-		cli.TargetLists:           listsFunc,
-		cli.TargetMedia:           mediaFunc,
-		cli.TargetMediaAttachment: mediaAttachmentFunc,
-		cli.TargetMutedAccounts:   mutedAccountsFunc,
-		cli.TargetNote:            noteFunc,
-		cli.TargetNotification:    notificationFunc,
-		cli.TargetNotifications:   notificationsFunc,
-		cli.TargetServer:          serverFunc,
-		cli.TargetStatus:          statusFunc,
-		cli.TargetTag:             tagFunc,
-		cli.TargetTags:            tagsFunc,
-		cli.TargetThread:          threadFunc,
-		cli.TargetTimeline:        timelineFunc,
-		cli.TargetToken:           tokenFunc,
-		cli.TargetTokens:          tokensFunc,
-		cli.TargetUsage:           usageFunc,
-		cli.TargetVersion:         versionFunc,
-		cli.TargetVotes:           votesFunc,
+		cli.TargetAccess:               accessFunc,
+		cli.TargetAccount:              accountFunc,
+		cli.TargetAccounts:             accountsFunc,
+		cli.TargetAlias:                aliasFunc,
+		cli.TargetAliases:              aliasesFunc,
+		cli.TargetBlockedAccounts:      blockedAccountsFunc,
+		cli.TargetBookmarks:            bookmarksFunc,
+		cli.TargetCache:                cacheFunc,
+		cli.TargetConfig:               configFunc,
+		cli.TargetConversation:         conversationFunc,
+		cli.TargetConversations:        conversationsFunc,
+		cli.TargetDraft:                draftFunc,
+		cli.TargetFavourites:           favouritesFunc,
+		cli.TargetFilter:               filterFunc,
+		cli.TargetFilterKeyword:        filterKeywordFunc,
+		cli.TargetFilterStatus:         filterStatusFunc,
+		cli.TargetFilters:              filtersFunc,
+		cli.TargetFollowRequest:        followRequestFunc,
+		cli.TargetFollowRequests:       followRequestsFunc,
+		cli.TargetFollowers:            followersFunc,
+		cli.TargetFollowings:           followingsFunc,
+		cli.TargetInstance:             instanceFunc,
+		cli.TargetInteractionPolicies:  interactionPoliciesFunc,
+		cli.TargetInteractionRequest:   interactionRequestFunc,
+		cli.TargetInteractionRequests:  interactionRequestsFunc,
+		cli.TargetList:                 listFunc,
+		cli.TargetLists:                listsFunc,
+		cli.TargetMedia:                mediaFunc,
+		cli.TargetMediaAttachment:      mediaAttachmentFunc,
+		cli.TargetMediaCache:           mediaCacheFunc,
+		cli.TargetMutedAccounts:        mutedAccountsFunc,
+		cli.TargetNote:                 noteFunc,
+		cli.TargetNotification:         notificationFunc,
+		cli.TargetNotificationPolicy:   notificationPolicyFunc,
+		cli.TargetNotificationRequest:  notificationRequestFunc,
+		cli.TargetNotificationRequests: notificationRequestsFunc,
+		cli.TargetNotifications:        notificationsFunc,
+		cli.TargetRateLimit:            rateLimitFunc,
+		cli.TargetScheduledStatus:      scheduledStatusFunc,
+		cli.TargetScheduledStatuses:    scheduledStatusesFunc,
+		cli.TargetServer:               serverFunc,
+		cli.TargetStatus:               statusFunc,
+		cli.TargetStatusHistory:        statusHistoryFunc,
+		cli.TargetStatuses:             statusesFunc,
+		cli.TargetTag:                  tagFunc,
+		cli.TargetTags:                 tagsFunc,
+		cli.TargetThread:               threadFunc,
+		cli.TargetTimeline:             timelineFunc,
+		cli.TargetToken:                tokenFunc,
+		cli.TargetTokens:               tokensFunc,
+		cli.TargetTui:                  tuiFunc,
+		cli.TargetUsage:                usageFunc,
+		cli.TargetVersion:              versionFunc,
+		cli.TargetVotes:                votesFunc,
 	}
 }
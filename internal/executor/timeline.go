@@ -33,6 +33,8 @@ func timelineFunc(
 	switch cmd.Action {
 	case cli.ActionShow:
 		return timelineShow(session.Client(), printSettings, cmd.FocusedTargetFlags)
+	case cli.ActionWatch:
+		return timelineWatch(session.Client(), printSettings, cmd.FocusedTargetFlags)
 	default:
 		return unsupportedActionError{action: cmd.Action, target: cli.TargetTimeline}
 	}
@@ -49,6 +51,10 @@ func timelineShow(
 		listID   string
 		tagName  string
 		category internalFlag.EnumValue
+		maxID    string
+		sinceID  string
+		all      bool
+		unread   bool
 	)
 
 	// Parse the remaining flags.
@@ -57,18 +63,44 @@ func timelineShow(
 		&listID,
 		&tagName,
 		&category,
+		&maxID,
+		&sinceID,
+		&all,
+		&unread,
 		flags,
 	); err != nil {
 		return err
 	}
 
+	pagination := gtsclient.PaginationArgs{
+		Limit:   limit,
+		MaxID:   maxID,
+		MinID:   "",
+		SinceID: sinceID,
+		All:     all,
+	}
+
+	var lastReadID string
+
+	if unread {
+		// Your read position is only kept for the home timeline.
+		if category.Value() != "home" {
+			return unreadTimelineCategoryError{category: category.Value()}
+		}
+
+		pagination, lastReadID, err = unreadPagination(client, gtsclient.MarkerHome, limit, maxID, sinceID, all)
+		if err != nil {
+			return err
+		}
+	}
+
 	var timeline model.StatusList
 
 	switch category.Value() {
 	case "home":
-		err = client.Call("GTSClient.GetHomeTimeline", limit, &timeline)
+		err = client.Call("GTSClient.GetHomeTimeline", pagination, &timeline)
 	case "public":
-		err = client.Call("GTSClient.GetPublicTimeline", limit, &timeline)
+		err = client.Call("GTSClient.GetPublicTimeline", pagination, &timeline)
 	case "list":
 		if listID == "" {
 			return missingIDError{
@@ -86,9 +118,9 @@ func timelineShow(
 		err = client.Call(
 			"GTSClient.GetListTimeline",
 			gtsclient.GetListTimelineArgs{
-				ListID: list.ID,
-				Title:  list.Title,
-				Limit:  limit,
+				ListID:     list.ID,
+				Title:      list.Title,
+				Pagination: pagination,
 			},
 			&timeline,
 		)
@@ -104,8 +136,8 @@ func timelineShow(
 		err = client.Call(
 			"GTSClient.GetTagTimeline",
 			gtsclient.GetTagTimelineArgs{
-				TagName: tagName,
-				Limit:   limit,
+				TagName:    tagName,
+				Pagination: pagination,
 			},
 			&timeline,
 		)
@@ -117,8 +149,12 @@ func timelineShow(
 		return fmt.Errorf("error retrieving the timeline: %w", err)
 	}
 
-	if len(timeline.Statuses) == 0 {
-		printer.PrintInfo("There are no statuses in this timeline.\n")
+	if len(timeline.Statuses) == 0 && printSettings.TextOutput() {
+		if unread {
+			printer.PrintInfo("There are no unread statuses in your home timeline.\n")
+		} else {
+			printer.PrintInfo("There are no statuses in this timeline.\n")
+		}
 
 		return nil
 	}
@@ -132,5 +168,74 @@ func timelineShow(
 		return fmt.Errorf("error printing the timeline: %w", err)
 	}
 
-	return nil
+	if !unread {
+		return nil
+	}
+
+	shownIDs := make([]string, len(timeline.Statuses))
+	for idx := range timeline.Statuses {
+		shownIDs[idx] = timeline.Statuses[idx].ID
+	}
+
+	return advanceMarker(client, gtsclient.MarkerHome, lastReadID, shownIDs)
+}
+
+func timelineWatch(
+	client *rpc.Client,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		listID   string
+		tagName  string
+		category internalFlag.EnumValue
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseTimelineWatchFlags(
+		&listID,
+		&tagName,
+		&category,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	args := gtsclient.OpenStreamArgs{
+		Stream:  "",
+		ListID:  "",
+		TagName: "",
+	}
+
+	switch category.Value() {
+	case "home":
+		args.Stream = gtsclient.StreamUser
+	case "public":
+		args.Stream = gtsclient.StreamPublic
+	case "list":
+		if listID == "" {
+			return missingIDError{
+				target: cli.TargetList,
+				action: "watch in the timeline",
+			}
+		}
+
+		args.Stream = gtsclient.StreamList
+		args.ListID = listID
+	case "tag":
+		if tagName == "" {
+			return missingValueError{
+				valueType: "name",
+				target:    cli.TargetTag,
+				action:    "watch the timeline in",
+			}
+		}
+
+		args.Stream = gtsclient.StreamHashtag
+		args.TagName = tagName
+	default:
+		return invalidTimelineCategoryError{category: category.Value()}
+	}
+
+	return watchStream(client, printSettings, args)
 }
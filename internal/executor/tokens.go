@@ -7,6 +7,7 @@ import (
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
@@ -47,11 +48,19 @@ func tokensShow(
 	printSettings printer.Settings,
 	flags []string,
 ) error {
-	var limit int
+	var (
+		limit   int
+		maxID   string
+		sinceID string
+		all     bool
+	)
 
 	// Parse the remaining flags
 	if err := cli.ParseTokensShowFlags(
 		&limit,
+		&maxID,
+		&sinceID,
+		&all,
 		flags,
 	); err != nil {
 		return err
@@ -60,13 +69,19 @@ func tokensShow(
 	var list model.TokenList
 	if err := client.Call(
 		"GTSClient.GetTokens",
-		limit,
+		gtsclient.PaginationArgs{
+			Limit:   limit,
+			MaxID:   maxID,
+			MinID:   "",
+			SinceID: sinceID,
+			All:     all,
+		},
 		&list,
 	); err != nil {
 		return fmt.Errorf("error retrieving the list of tokens: %w", err)
 	}
 
-	if len(list.Tokens) > 0 {
+	if len(list.Tokens) > 0 || !printSettings.TextOutput() {
 		if err := printer.PrintTokenList(printSettings, list); err != nil {
 			return fmt.Errorf("error printing the list of tokens: %w", err)
 		}
@@ -0,0 +1,94 @@
+package executor
+
+import (
+	"fmt"
+	"net/rpc"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/cli"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/command"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/server"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/tui"
+)
+
+func tuiFunc(
+	cfg config.Config,
+	printSettings printer.Settings,
+	cmd command.Command,
+) error {
+	if cfg.IsZero() {
+		return zeroConfigurationError{path: cfg.Path}
+	}
+
+	// Create the session to interact with the GoToSocial instance.
+	session, err := server.StartSession(cfg.Server, cfg.Path)
+	if err != nil {
+		return fmt.Errorf("error creating the client for the daemon process: %w", err)
+	}
+	defer server.EndSession(session)
+
+	switch cmd.Action {
+	case cli.ActionStart:
+		return tuiStart(session.Client(), cfg, printSettings, cmd.FocusedTargetFlags)
+	default:
+		return unsupportedActionError{action: cmd.Action, target: cli.TargetTui}
+	}
+}
+
+func tuiStart(
+	client *rpc.Client,
+	cfg config.Config,
+	printSettings printer.Settings,
+	flags []string,
+) error {
+	var (
+		listID   string
+		tagName  string
+		category internalFlag.EnumValue
+	)
+
+	// Parse the remaining flags.
+	if err := cli.ParseTuiStartFlags(
+		&listID,
+		&tagName,
+		&category,
+		flags,
+	); err != nil {
+		return err
+	}
+
+	switch category.Value() {
+	case "list":
+		if listID == "" {
+			return missingIDError{
+				target: cli.TargetList,
+				action: "browse the timeline of",
+			}
+		}
+	case "tag":
+		if tagName == "" {
+			return missingValueError{
+				valueType: "name",
+				target:    cli.TargetTag,
+				action:    "browse the timeline of",
+			}
+		}
+	}
+
+	if err := tui.Run(
+		client,
+		cfg,
+		printSettings,
+		tui.StartArgs{
+			View:    category.Value(),
+			ListID:  listID,
+			TagName: tagName,
+		},
+	); err != nil {
+		return fmt.Errorf("error running the terminal user interface: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package flag
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeValue is a flag value for a point in time. The value can either be an
+// absolute time (e.g. "2025-06-01T15:30:00+01:00" or "2025-06-01 15:30") or a
+// time relative to now using the same format as TimeDurationValue (e.g. "2 hours").
+type TimeValue struct {
+	time  time.Time
+	isSet bool
+}
+
+func NewTimeValue() TimeValue {
+	return TimeValue{
+		time:  time.Time{},
+		isSet: false,
+	}
+}
+
+func (v *TimeValue) String() string {
+	if v.time.IsZero() {
+		return ""
+	}
+
+	return v.time.Format(time.RFC3339)
+}
+
+func (v *TimeValue) Set(value string) error {
+	layouts := []string{
+		time.RFC3339,
+		"2006-01-02T15:04",
+		"2006-01-02 15:04",
+	}
+
+	for _, layout := range layouts {
+		parsed, err := time.ParseInLocation(layout, value, time.Local)
+		if err == nil {
+			v.time = parsed
+			v.isSet = true
+
+			return nil
+		}
+	}
+
+	duration := NewTimeDurationValue(0)
+	if err := duration.Set(value); err != nil {
+		return fmt.Errorf("unable to parse %q as a time or a duration: %w", value, err)
+	}
+
+	if duration.Value() == 0 {
+		return fmt.Errorf(
+			"unable to parse %q as a time (e.g. \"2006-01-02 15:04\") or a duration (e.g. \"2 hours\")",
+			value,
+		)
+	}
+
+	v.time = time.Now().Add(duration.Value())
+	v.isSet = true
+
+	return nil
+}
+
+func (v *TimeValue) IsSet() bool {
+	return v.isSet
+}
+
+func (v *TimeValue) Value() time.Time {
+	return v.time
+}
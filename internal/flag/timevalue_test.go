@@ -0,0 +1,86 @@
+package flag_test
+
+import (
+	"flag"
+	"slices"
+	"testing"
+	"time"
+
+	internalFlag "codeflow.dananglin.me.uk/apollo/enbas/internal/flag"
+)
+
+func TestTimeValue(t *testing.T) {
+	absoluteTests := []struct {
+		input string
+		want  time.Time
+	}{
+		{
+			input: "2025-06-01T15:30:00Z",
+			want:  time.Date(2025, time.June, 1, 15, 30, 0, 0, time.UTC),
+		},
+		{
+			input: "2025-06-01 15:30",
+			want:  time.Date(2025, time.June, 1, 15, 30, 0, 0, time.Local),
+		},
+		{
+			input: "2025-06-01T15:30",
+			want:  time.Date(2025, time.June, 1, 15, 30, 0, 0, time.Local),
+		},
+	}
+
+	for _, test := range slices.All(absoluteTests) {
+		t.Run("Absolute time: "+test.input, func(t *testing.T) {
+			t.Parallel()
+
+			value := parseTimeValue(t, test.input)
+
+			if !value.Value().Equal(test.want) {
+				t.Errorf("Unexpected time parsed from the flag: want %s, got %s", test.want, value.Value())
+			}
+		})
+	}
+
+	t.Run("Relative time: 2 hours and 30 minutes", func(t *testing.T) {
+		t.Parallel()
+
+		before := time.Now()
+		value := parseTimeValue(t, "2 hours and 30 minutes")
+		want := before.Add(150 * time.Minute)
+
+		if got := value.Value(); got.Before(want) || got.After(want.Add(time.Minute)) {
+			t.Errorf("Unexpected time parsed from the flag: want about %s, got %s", want, got)
+		}
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		value := internalFlag.NewTimeValue()
+		if err := value.Set("next tuesday"); err == nil {
+			t.Error("Expected an error after parsing an invalid time, but did not receive one")
+		}
+
+		if value.IsSet() {
+			t.Error("Unexpected result received from IsSet() method: want false, got true")
+		}
+	})
+}
+
+func parseTimeValue(t *testing.T, input string) internalFlag.TimeValue {
+	t.Helper()
+
+	flagset := flag.NewFlagSet("test", flag.ContinueOnError)
+	value := internalFlag.NewTimeValue()
+
+	flagset.Var(&value, "scheduled-at", "Time value")
+
+	if err := flagset.Parse([]string{"--scheduled-at", input}); err != nil {
+		t.Fatalf("Received an error parsing the flag: %v", err)
+	}
+
+	if !value.IsSet() {
+		t.Fatal("Unexpected result received from IsSet() method: want true, got false")
+	}
+
+	return value
+}
@@ -0,0 +1,28 @@
+package frontmatter
+
+import "fmt"
+
+type UnclosedHeaderError struct{}
+
+func (e UnclosedHeaderError) Error() string {
+	return "the front matter header is not closed with '" + delimiter + "'"
+}
+
+type InvalidLineError struct {
+	lineNum int
+	line    string
+}
+
+func (e InvalidLineError) Error() string {
+	return fmt.Sprintf("unable to parse line %d of the front matter header: %q", e.lineNum, e.line)
+}
+
+type InvalidValueError struct {
+	key   string
+	value string
+	kind  string
+}
+
+func (e InvalidValueError) Error() string {
+	return fmt.Sprintf("the value %q of %q is not a valid %s", e.value, e.key, e.kind)
+}
@@ -0,0 +1,377 @@
+package frontmatter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const delimiter string = "---"
+
+// Header is the front matter block at the top of a document. The header is written
+// in a small subset of YAML which supports comments, scalar values, lists of scalar
+// values and lists of objects.
+//
+//	---
+//	# A comment.
+//	summary: A summary
+//	poll-options:
+//	  - Yes
+//	  - No
+//	media:
+//	  - file: /path/to/image.png
+//	    description: A description of the image
+//	---
+type Header struct {
+	entries []entry
+}
+
+type entry struct {
+	key     string
+	comment string
+	value   string
+	items   []item
+}
+
+type item struct {
+	text   string
+	fields Object
+}
+
+// Field is a key-value pair within an object.
+type Field struct {
+	Key   string
+	Value string
+}
+
+// Object is an ordered set of fields.
+type Object []Field
+
+// Get returns the value of the field with the specified key.
+func (o Object) Get(key string) string {
+	for idx := range o {
+		if o[idx].Key == key {
+			return o[idx].Value
+		}
+	}
+
+	return ""
+}
+
+// AddComment adds a comment line to the header.
+func (h *Header) AddComment(comment string) {
+	h.entries = append(h.entries, entry{
+		key:     "",
+		comment: comment,
+		value:   "",
+		items:   nil,
+	})
+}
+
+// Set sets the scalar value of the specified key.
+func (h *Header) Set(key, value string) {
+	h.set(entry{key: key, comment: "", value: value, items: nil})
+}
+
+// SetBool sets the boolean value of the specified key.
+func (h *Header) SetBool(key string, value bool) {
+	h.Set(key, strconv.FormatBool(value))
+}
+
+// SetList sets the specified key to a list of scalar values.
+func (h *Header) SetList(key string, values []string) {
+	items := make([]item, len(values))
+
+	for idx := range values {
+		items[idx] = item{text: values[idx], fields: nil}
+	}
+
+	h.set(entry{key: key, comment: "", value: "", items: items})
+}
+
+// SetObjects sets the specified key to a list of objects.
+func (h *Header) SetObjects(key string, objects []Object) {
+	items := make([]item, len(objects))
+
+	for idx := range objects {
+		items[idx] = item{text: "", fields: objects[idx]}
+	}
+
+	h.set(entry{key: key, comment: "", value: "", items: items})
+}
+
+func (h *Header) set(newEntry entry) {
+	for idx := range h.entries {
+		if h.entries[idx].key == newEntry.key {
+			h.entries[idx] = newEntry
+
+			return
+		}
+	}
+
+	h.entries = append(h.entries, newEntry)
+}
+
+// Has returns true if the header contains the specified key.
+func (h Header) Has(key string) bool {
+	_, ok := h.lookup(key)
+
+	return ok
+}
+
+// Get returns the scalar value of the specified key.
+func (h Header) Get(key string) string {
+	found, _ := h.lookup(key)
+
+	return found.value
+}
+
+// Bool returns the boolean value of the specified key. False is returned
+// for the second value if the key is not present in the header.
+func (h Header) Bool(key string) (bool, bool, error) {
+	found, ok := h.lookup(key)
+	if !ok || found.value == "" {
+		return false, false, nil
+	}
+
+	value, err := strconv.ParseBool(found.value)
+	if err != nil {
+		return false, true, InvalidValueError{key: key, value: found.value, kind: "boolean"}
+	}
+
+	return value, true, nil
+}
+
+// List returns the list of scalar values of the specified key.
+func (h Header) List(key string) []string {
+	found, _ := h.lookup(key)
+
+	values := make([]string, len(found.items))
+
+	for idx := range found.items {
+		values[idx] = found.items[idx].text
+	}
+
+	return values
+}
+
+// Objects returns the list of objects of the specified key.
+func (h Header) Objects(key string) ([]Object, error) {
+	found, _ := h.lookup(key)
+
+	objects := make([]Object, len(found.items))
+
+	for idx := range found.items {
+		if found.items[idx].fields == nil {
+			return nil, InvalidValueError{key: key, value: found.items[idx].text, kind: "object"}
+		}
+
+		objects[idx] = found.items[idx].fields
+	}
+
+	return objects, nil
+}
+
+func (h Header) lookup(key string) (entry, bool) {
+	for idx := range h.entries {
+		if h.entries[idx].comment == "" && h.entries[idx].key == key {
+			return h.entries[idx], true
+		}
+	}
+
+	return entry{}, false
+}
+
+// Format formats the header and the body into a single document.
+func Format(header Header, body string) string {
+	var builder strings.Builder
+
+	builder.WriteString(delimiter + "\n")
+
+	for _, headerEntry := range header.entries {
+		switch {
+		case headerEntry.comment != "":
+			builder.WriteString("# " + headerEntry.comment + "\n")
+		case headerEntry.items != nil:
+			builder.WriteString(headerEntry.key + ":\n")
+
+			for _, listItem := range headerEntry.items {
+				if listItem.fields == nil {
+					builder.WriteString("  - " + quote(listItem.text) + "\n")
+
+					continue
+				}
+
+				for idx, field := range listItem.fields {
+					prefix := "    "
+					if idx == 0 {
+						prefix = "  - "
+					}
+
+					builder.WriteString(prefix + field.Key + ": " + quote(field.Value) + "\n")
+				}
+			}
+		default:
+			builder.WriteString(headerEntry.key + ": " + quote(headerEntry.value) + "\n")
+		}
+	}
+
+	builder.WriteString(delimiter + "\n")
+	builder.WriteString(body)
+
+	if !strings.HasSuffix(body, "\n") {
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+// Parse parses the document into the header and the body. The whole document
+// is returned as the body if it does not begin with a header.
+func Parse(document string) (Header, string, error) {
+	var header Header
+
+	lines := strings.Split(strings.ReplaceAll(document, "\r\n", "\n"), "\n")
+
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != delimiter {
+		return header, strings.TrimSpace(document), nil
+	}
+
+	closed := false
+	bodyStart := len(lines)
+
+	for idx := 1; idx < len(lines); idx++ {
+		line := lines[idx]
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == delimiter {
+			closed = true
+			bodyStart = idx + 1
+
+			break
+		}
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if err := header.parseLine(line, idx+1); err != nil {
+			return Header{}, "", err
+		}
+	}
+
+	if !closed {
+		return Header{}, "", UnclosedHeaderError{}
+	}
+
+	return header, strings.TrimSpace(strings.Join(lines[bodyStart:], "\n")), nil
+}
+
+func (h *Header) parseLine(line string, lineNum int) error {
+	trimmed := strings.TrimSpace(line)
+
+	// Lines that are not indented are the top level keys.
+	if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+		key, value, found := strings.Cut(trimmed, ":")
+		if !found || strings.TrimSpace(key) == "" {
+			return InvalidLineError{lineNum: lineNum, line: line}
+		}
+
+		unquoted, err := unquote(strings.TrimSpace(value))
+		if err != nil {
+			return InvalidLineError{lineNum: lineNum, line: line}
+		}
+
+		h.entries = append(h.entries, entry{
+			key:     strings.TrimSpace(key),
+			comment: "",
+			value:   unquoted,
+			items:   nil,
+		})
+
+		return nil
+	}
+
+	// Indented lines belong to the list of the previous key.
+	if len(h.entries) == 0 || h.entries[len(h.entries)-1].value != "" {
+		return InvalidLineError{lineNum: lineNum, line: line}
+	}
+
+	last := &h.entries[len(h.entries)-1]
+
+	if text, isItem := strings.CutPrefix(trimmed, "-"); isItem {
+		text = strings.TrimSpace(text)
+
+		unquoted, err := unquote(text)
+		if err != nil {
+			// The item may be the first field of an object.
+			unquoted = text
+		}
+
+		newItem := item{text: unquoted, fields: nil}
+
+		if field, ok := parseField(text); ok {
+			newItem.fields = Object{field}
+		}
+
+		last.items = append(last.items, newItem)
+
+		return nil
+	}
+
+	// The remaining lines are the additional fields of an object.
+	if len(last.items) == 0 || last.items[len(last.items)-1].fields == nil {
+		return InvalidLineError{lineNum: lineNum, line: line}
+	}
+
+	field, ok := parseField(trimmed)
+	if !ok {
+		return InvalidLineError{lineNum: lineNum, line: line}
+	}
+
+	lastItem := &last.items[len(last.items)-1]
+	lastItem.fields = append(lastItem.fields, field)
+
+	return nil
+}
+
+func parseField(text string) (Field, bool) {
+	key, value, found := strings.Cut(text, ":")
+	if !found || strings.TrimSpace(key) == "" || strings.ContainsAny(key, " \t\"") {
+		return Field{}, false
+	}
+
+	unquoted, err := unquote(strings.TrimSpace(value))
+	if err != nil {
+		return Field{}, false
+	}
+
+	return Field{Key: key, Value: unquoted}, true
+}
+
+func quote(value string) string {
+	if value == "" ||
+		value != strings.TrimSpace(value) ||
+		strings.ContainsAny(value, "\n\t") ||
+		strings.HasPrefix(value, "\"") ||
+		strings.HasPrefix(value, "#") ||
+		strings.HasPrefix(value, "-") ||
+		strings.Contains(value, ":") {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
+
+func unquote(value string) (string, error) {
+	if !strings.HasPrefix(value, "\"") {
+		return value, nil
+	}
+
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return "", fmt.Errorf("unable to unquote %s: %w", value, err)
+	}
+
+	return unquoted, nil
+}
@@ -0,0 +1,174 @@
+package frontmatter_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/frontmatter"
+)
+
+func TestParse(t *testing.T) {
+	document := `---
+# Edit the status below.
+summary: "Thoughts: part 2"
+sensitive: true
+language: en
+poll-options:
+  - Yes
+  - "No: never"
+media:
+  - id: 01J4VQ6XG5GCWZ3TZFS1KAKZ7E
+    description: A photo of a cat
+  - id: 01J4VQ8R0YEY5KJAFQ1R6M2K3N
+    description: ""
+---
+
+Hello, world!
+`
+
+	header, body, err := frontmatter.Parse(document)
+	if err != nil {
+		t.Fatalf("Unable to parse the document: %v", err)
+	}
+
+	if want := "Hello, world!"; body != want {
+		t.Errorf("Unexpected body received: want %q, got %q", want, body)
+	}
+
+	if want, got := "Thoughts: part 2", header.Get("summary"); want != got {
+		t.Errorf("Unexpected summary received: want %q, got %q", want, got)
+	}
+
+	sensitive, isSet, err := header.Bool("sensitive")
+	if err != nil {
+		t.Fatalf("Unable to parse the sensitive field: %v", err)
+	}
+
+	if !sensitive || !isSet {
+		t.Errorf("Unexpected sensitive value received: want true, got %t (set: %t)", sensitive, isSet)
+	}
+
+	if want, got := []string{"Yes", "No: never"}, header.List("poll-options"); !slices.Equal(want, got) {
+		t.Errorf("Unexpected poll options received: want %v, got %v", want, got)
+	}
+
+	media, err := header.Objects("media")
+	if err != nil {
+		t.Fatalf("Unable to parse the media objects: %v", err)
+	}
+
+	if len(media) != 2 {
+		t.Fatalf("Unexpected number of media objects received: want 2, got %d", len(media))
+	}
+
+	if want, got := "A photo of a cat", media[0].Get("description"); want != got {
+		t.Errorf("Unexpected media description received: want %q, got %q", want, got)
+	}
+
+	if want, got := "01J4VQ8R0YEY5KJAFQ1R6M2K3N", media[1].Get("id"); want != got {
+		t.Errorf("Unexpected media ID received: want %q, got %q", want, got)
+	}
+}
+
+func TestFormatAndParse(t *testing.T) {
+	var header frontmatter.Header
+
+	header.AddComment("This is a comment.")
+	header.Set("summary", " leading and trailing spaces ")
+	header.Set("visibility", "public")
+	header.SetBool("sensitive", false)
+	header.SetList("poll-options", []string{"- a dash", "#hashtag", "plain"})
+	header.SetObjects("media", []frontmatter.Object{
+		{
+			{Key: "file", Value: "/tmp/image.png"},
+			{Key: "description", Value: "Line one\nLine two"},
+		},
+	})
+
+	body := "The body of the status.\n\nWith two paragraphs."
+
+	header, gotBody, err := frontmatter.Parse(frontmatter.Format(header, body))
+	if err != nil {
+		t.Fatalf("Unable to parse the formatted document: %v", err)
+	}
+
+	if gotBody != body {
+		t.Errorf("Unexpected body received: want %q, got %q", body, gotBody)
+	}
+
+	if want, got := " leading and trailing spaces ", header.Get("summary"); want != got {
+		t.Errorf("Unexpected summary received: want %q, got %q", want, got)
+	}
+
+	if want, got := []string{"- a dash", "#hashtag", "plain"}, header.List("poll-options"); !slices.Equal(want, got) {
+		t.Errorf("Unexpected poll options received: want %v, got %v", want, got)
+	}
+
+	media, err := header.Objects("media")
+	if err != nil {
+		t.Fatalf("Unable to parse the media objects: %v", err)
+	}
+
+	if want, got := "Line one\nLine two", media[0].Get("description"); want != got {
+		t.Errorf("Unexpected media description received: want %q, got %q", want, got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		document string
+		want     error
+	}{
+		{
+			name:     "The header is not closed",
+			document: "---\nsummary: test\nlanguage: en\n",
+			want:     frontmatter.UnclosedHeaderError{},
+		},
+		{
+			name:     "The line has no key",
+			document: "---\njust some text\n---\nHello",
+			want:     frontmatter.InvalidLineError{},
+		},
+	}
+
+	for _, tc := range slices.All(cases) {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := frontmatter.Parse(tc.document)
+			if err == nil {
+				t.Fatal("Expected an error but did not receive one")
+			}
+
+			switch tc.want.(type) {
+			case frontmatter.UnclosedHeaderError:
+				var target frontmatter.UnclosedHeaderError
+				if !errors.As(err, &target) {
+					t.Errorf("Unexpected error received: got %v", err)
+				}
+			case frontmatter.InvalidLineError:
+				var target frontmatter.InvalidLineError
+				if !errors.As(err, &target) {
+					t.Errorf("Unexpected error received: got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseWithoutHeader(t *testing.T) {
+	header, body, err := frontmatter.Parse("\nJust the body.\n")
+	if err != nil {
+		t.Fatalf("Unable to parse the document: %v", err)
+	}
+
+	if header.Has("summary") {
+		t.Error("Unexpected summary found in the header")
+	}
+
+	if want := "Just the body."; body != want {
+		t.Errorf("Unexpected body received: want %q, got %q", want, body)
+	}
+}
@@ -16,10 +16,11 @@ type Definitions struct {
 }
 
 type TopLevelFlag struct {
-	Description string `json:"description"`
-	Type        string `json:"type"`
-	Default     string `json:"default"`
-	Required    bool   `json:"bool"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"`
+	Default     string   `json:"default"`
+	Enum        []string `json:"enum"`
+	Required    bool     `json:"bool"`
 }
 
 type BuiltInAlias struct {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"slices"
 
@@ -28,6 +29,125 @@ func (g *GTSClient) GetMyAccount(_ NoRPCArgs, account *model.Account) error {
 	return nil
 }
 
+// UpdateCredentialsForm is the form for updating the profile and the settings
+// of your account. Only the values that are not empty are sent to the instance.
+// The boolean settings are set to "true" or "false" so that they can be left
+// unchanged when they are empty.
+type UpdateCredentialsForm struct {
+	DisplayName       string
+	Note              string
+	ClearNote         bool
+	Fields            []model.Field
+	AvatarPath        string
+	AvatarDescription string
+	HeaderPath        string
+	HeaderDescription string
+	Locked            string
+	Bot               string
+	Discoverable      string
+	Privacy           string
+	Language          string
+	StatusContentType string
+	Theme             string
+}
+
+// IsZero returns true if there are no changes in the form.
+func (f UpdateCredentialsForm) IsZero() bool {
+	return f.DisplayName == "" &&
+		f.Note == "" &&
+		!f.ClearNote &&
+		len(f.Fields) == 0 &&
+		f.AvatarPath == "" &&
+		f.AvatarDescription == "" &&
+		f.HeaderPath == "" &&
+		f.HeaderDescription == "" &&
+		f.Locked == "" &&
+		f.Bot == "" &&
+		f.Discoverable == "" &&
+		f.Privacy == "" &&
+		f.Language == "" &&
+		f.StatusContentType == "" &&
+		f.Theme == ""
+}
+
+func (g *GTSClient) UpdateCredentials(form UpdateCredentialsForm, account *model.Account) error {
+	// create the request body using a writer from the multipart package
+	requestBody := bytes.Buffer{}
+	requestBodyWriter := multipart.NewWriter(&requestBody)
+
+	if form.AvatarPath != "" {
+		if err := writeFormFile(requestBodyWriter, "avatar", form.AvatarPath); err != nil {
+			return fmt.Errorf("unable to add the avatar to the form: %w", err)
+		}
+	}
+
+	if form.HeaderPath != "" {
+		if err := writeFormFile(requestBodyWriter, "header", form.HeaderPath); err != nil {
+			return fmt.Errorf("unable to add the header to the form: %w", err)
+		}
+	}
+
+	type formField struct {
+		name  string
+		value string
+	}
+
+	fields := []formField{
+		{name: "display_name", value: form.DisplayName},
+		{name: "note", value: form.Note},
+		{name: "avatar_description", value: form.AvatarDescription},
+		{name: "header_description", value: form.HeaderDescription},
+		{name: "locked", value: form.Locked},
+		{name: "bot", value: form.Bot},
+		{name: "discoverable", value: form.Discoverable},
+		{name: "source[privacy]", value: form.Privacy},
+		{name: "source[language]", value: form.Language},
+		{name: "source[status_content_type]", value: form.StatusContentType},
+		{name: "theme", value: form.Theme},
+	}
+
+	for idx := range form.Fields {
+		fields = append(
+			fields,
+			formField{name: fmt.Sprintf("fields_attributes[%d][name]", idx), value: form.Fields[idx].Name},
+			formField{name: fmt.Sprintf("fields_attributes[%d][value]", idx), value: form.Fields[idx].Value},
+		)
+	}
+
+	for _, field := range fields {
+		if field.value == "" && (field.name != "note" || !form.ClearNote) {
+			continue
+		}
+
+		if err := writeFormField(requestBodyWriter, field.name, field.value); err != nil {
+			return err
+		}
+	}
+
+	if err := requestBodyWriter.Close(); err != nil {
+		return fmt.Errorf("unable to close the writer: %w", err)
+	}
+
+	params := requestParameters{
+		httpMethod:  http.MethodPatch,
+		url:         g.auth.GetInstanceURL() + baseAccountsPath + "/update_credentials",
+		requestBody: &requestBody,
+		contentType: requestBodyWriter.FormDataContentType(),
+		output:      account,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to update your account: %w",
+			err,
+		)
+	}
+
+	g.memoryCache.invalidateAccount(account.ID)
+
+	return nil
+}
+
 func (g *GTSClient) GetMyAccountID(_ NoRPCArgs, accountID *string) error {
 	currentAccountID := g.auth.GetCurrentAccountID()
 	if currentAccountID != "" {
@@ -127,6 +247,12 @@ func (g *GTSClient) GetMultipleAccountIDs(accountURIs []string, accountIDs *[]st
 }
 
 func (g *GTSClient) getAccount(accountURI string) (model.Account, error) {
+	key := accountKey(accountURI)
+
+	if account, ok := g.memoryCache.accounts.Get(key); ok {
+		return account, nil
+	}
+
 	var account model.Account
 
 	params := requestParameters{
@@ -144,10 +270,18 @@ func (g *GTSClient) getAccount(accountURI string) (model.Account, error) {
 		)
 	}
 
+	g.memoryCache.accounts.Add(key, account)
+
 	return account, nil
 }
 
 func (g *GTSClient) GetAccountRelationship(accountID string, relationship *model.AccountRelationship) error {
+	if cached, ok := g.memoryCache.relationships.Get(accountID); ok {
+		*relationship = cached
+
+		return nil
+	}
+
 	var relationships []model.AccountRelationship
 
 	params := requestParameters{
@@ -174,6 +308,8 @@ func (g *GTSClient) GetAccountRelationship(accountID string, relationship *model
 
 	*relationship = relationships[0]
 
+	g.memoryCache.relationships.Add(accountID, *relationship)
+
 	return nil
 }
 
@@ -213,6 +349,8 @@ func (g *GTSClient) FollowAccount(args FollowAccountArgs, _ *NoRPCResults) error
 		return fmt.Errorf("received an error after sending the follow request: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(args.AccountID)
+
 	return nil
 }
 
@@ -229,26 +367,24 @@ func (g *GTSClient) UnfollowAccount(accountID string, _ *NoRPCResults) error {
 		return fmt.Errorf("received an error after sending the request to unfollow the account: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(accountID)
+
 	return nil
 }
 
 type GetFollowersArgs struct {
-	AccountID string
-	Limit     int
+	AccountID  string
+	Pagination PaginationArgs
 }
 
 func (g *GTSClient) GetFollowers(args GetFollowersArgs, followers *model.AccountList) error {
-	var accounts []model.Account
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("%s/%s/followers?limit=%d", baseAccountsPath, args.AccountID, args.Limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &accounts,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+	accounts, pagination, err := getPaginatedList[model.Account](
+		g,
+		baseAccountsPath+"/"+args.AccountID+"/followers",
+		"",
+		args.Pagination,
+	)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the list of followers: %w",
 			err,
@@ -259,28 +395,25 @@ func (g *GTSClient) GetFollowers(args GetFollowersArgs, followers *model.Account
 		Label:           "Followed by",
 		Accounts:        accounts,
 		BlockedAccounts: false,
+		Pagination:      pagination,
 	}
 
 	return nil
 }
 
 type GetFollowingsArgs struct {
-	AccountID string
-	Limit     int
+	AccountID  string
+	Pagination PaginationArgs
 }
 
 func (g *GTSClient) GetFollowing(args GetFollowingsArgs, following *model.AccountList) error {
-	var accounts []model.Account
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("%s/%s/following?limit=%d", baseAccountsPath, args.AccountID, args.Limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &accounts,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+	accounts, pagination, err := getPaginatedList[model.Account](
+		g,
+		baseAccountsPath+"/"+args.AccountID+"/following",
+		"",
+		args.Pagination,
+	)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the list of followed accounts: %w",
 			err,
@@ -291,6 +424,7 @@ func (g *GTSClient) GetFollowing(args GetFollowingsArgs, following *model.Accoun
 		Label:           "Following",
 		Accounts:        accounts,
 		BlockedAccounts: false,
+		Pagination:      pagination,
 	}
 
 	return nil
@@ -309,6 +443,8 @@ func (g *GTSClient) BlockAccount(accountID string, _ *NoRPCResults) error {
 		return fmt.Errorf("received an error after sending the request to block the account: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(accountID)
+
 	return nil
 }
 
@@ -325,21 +461,14 @@ func (g *GTSClient) UnblockAccount(accountID string, _ *NoRPCResults) error {
 		return fmt.Errorf("received an error after sending the request to unblock the account: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(accountID)
+
 	return nil
 }
 
-func (g *GTSClient) GetBlockedAccounts(limit int, blocked *model.AccountList) error {
-	var accounts []model.Account
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("/api/v1/blocks?limit=%d", limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &accounts,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) GetBlockedAccounts(args PaginationArgs, blocked *model.AccountList) error {
+	accounts, pagination, err := getPaginatedList[model.Account](g, "/api/v1/blocks", "", args)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the list of blocked accounts: %w",
 			err,
@@ -350,6 +479,7 @@ func (g *GTSClient) GetBlockedAccounts(limit int, blocked *model.AccountList) er
 		Label:           "Blocked accounts",
 		Accounts:        accounts,
 		BlockedAccounts: true,
+		Pagination:      pagination,
 	}
 
 	return nil
@@ -386,21 +516,14 @@ func (g *GTSClient) SetPrivateNote(args SetPrivateNoteArgs, _ *NoRPCResults) err
 		return fmt.Errorf("received an error after sending the request to set the private note: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(args.AccountID)
+
 	return nil
 }
 
-func (g *GTSClient) GetFollowRequests(limit int, requests *model.AccountList) error {
-	var accounts []model.Account
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("%s?limit=%d", baseFollowRequestsPath, limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &accounts,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) GetFollowRequests(args PaginationArgs, requests *model.AccountList) error {
+	accounts, pagination, err := getPaginatedList[model.Account](g, baseFollowRequestsPath, "", args)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the list of follow requests: %w",
 			err,
@@ -411,6 +534,7 @@ func (g *GTSClient) GetFollowRequests(limit int, requests *model.AccountList) er
 		Label:           "Accounts that have requested to follow you",
 		Accounts:        accounts,
 		BlockedAccounts: false,
+		Pagination:      pagination,
 	}
 
 	return nil
@@ -429,6 +553,8 @@ func (g *GTSClient) AcceptFollowRequest(accountID string, _ *NoRPCResults) error
 		return fmt.Errorf("received an error after sending the request to accept the follow request: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(accountID)
+
 	return nil
 }
 
@@ -445,21 +571,14 @@ func (g *GTSClient) RejectFollowRequest(accountID string, _ *NoRPCResults) error
 		return fmt.Errorf("received an error after sending the request to reject the follow request: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(accountID)
+
 	return nil
 }
 
-func (g *GTSClient) GetMutedAccounts(limit int, muted *model.AccountList) error {
-	var accounts []model.Account
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("/api/v1/mutes?limit=%d", limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &accounts,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) GetMutedAccounts(args PaginationArgs, muted *model.AccountList) error {
+	accounts, pagination, err := getPaginatedList[model.Account](g, "/api/v1/mutes", "", args)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the list of muted accounts: %w",
 			err,
@@ -470,6 +589,7 @@ func (g *GTSClient) GetMutedAccounts(limit int, muted *model.AccountList) error
 		Label:           "Muted accounts",
 		Accounts:        accounts,
 		BlockedAccounts: false,
+		Pagination:      pagination,
 	}
 
 	return nil
@@ -509,6 +629,8 @@ func (g *GTSClient) MuteAccount(args MuteAccountArgs, _ *NoRPCResults) error {
 		return fmt.Errorf("received an error after sending the request to mute the account: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(args.AccountID)
+
 	return nil
 }
 
@@ -525,12 +647,14 @@ func (g *GTSClient) UnmuteAccount(accountID string, _ *NoRPCResults) error {
 		return fmt.Errorf("received an error after sending the request to unmute the account: %w", err)
 	}
 
+	g.memoryCache.invalidateAccount(accountID)
+
 	return nil
 }
 
 type GetAccountStatusesArgs struct {
 	AccountID      string
-	Limit          int
+	Pagination     PaginationArgs
 	ExcludeReplies bool
 	ExcludeReblogs bool
 	Pinned         bool
@@ -541,8 +665,7 @@ type GetAccountStatusesArgs struct {
 func (g *GTSClient) GetAccountStatuses(args GetAccountStatusesArgs, statusList *model.StatusList) error {
 	path := baseAccountsPath + "/" + args.AccountID + "/statuses"
 	query := fmt.Sprintf(
-		"?limit=%d&exclude_replies=%t&exclude_reblogs=%t&pinned=%t&only_media=%t&only_public=%t",
-		args.Limit,
+		"exclude_replies=%t&exclude_reblogs=%t&pinned=%t&only_media=%t&only_public=%t",
 		args.ExcludeReplies,
 		args.ExcludeReblogs,
 		args.Pinned,
@@ -550,17 +673,8 @@ func (g *GTSClient) GetAccountStatuses(args GetAccountStatusesArgs, statusList *
 		args.OnlyPublic,
 	)
 
-	var statuses []model.Status
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + path + query,
-		requestBody: nil,
-		contentType: "",
-		output:      &statuses,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+	statuses, pagination, err := getPaginatedList[model.Status](g, path, query, args.Pagination)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the account's statuses: %w",
 			err,
@@ -568,8 +682,9 @@ func (g *GTSClient) GetAccountStatuses(args GetAccountStatusesArgs, statusList *
 	}
 
 	*statusList = model.StatusList{
-		Name:     "STATUSES:",
-		Statuses: statuses,
+		Name:       "STATUSES:",
+		Statuses:   statuses,
+		Pagination: pagination,
 	}
 
 	return nil
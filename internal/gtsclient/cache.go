@@ -0,0 +1,171 @@
+package gtsclient
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/httpcache"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+const (
+	resourceTypeAccounts string = "accounts"
+	resourceTypeInstance string = "instance"
+	resourceTypeLists    string = "lists"
+	resourceTypeOther    string = "other"
+
+	// accountKeyLength is the number of characters of the access token's
+	// checksum used to identify the account's cache directory.
+	accountKeyLength int = 16
+)
+
+// accountCollections are the endpoints under /api/v1/accounts that
+// return a collection instead of a single account.
+var accountCollections = []string{ //nolint:gochecknoglobals
+	"familiar_followers",
+	"relationships",
+	"search",
+}
+
+// responseCache returns the cache of responses for the account that is currently in use.
+// False is returned if caching is disabled.
+func (g *GTSClient) responseCache() (httpcache.Cache, bool, error) {
+	if !g.cacheResponses {
+		return httpcache.Cache{}, false, nil
+	}
+
+	// The account is identified by the checksum of its access token so that
+	// the responses of different accounts on the same instance are kept apart.
+	accountKey := httpcache.Key(g.auth.GetToken())[:accountKeyLength]
+
+	dir, err := utilities.CalculateResponsesCacheDir(g.cacheRoot, g.auth.GetInstanceURL(), accountKey)
+	if err != nil {
+		return httpcache.Cache{}, false, fmt.Errorf("unable to calculate the response cache directory: %w", err)
+	}
+
+	return httpcache.New(dir), true, nil
+}
+
+// cacheTTL returns how long the cached response for the URL is used
+// before revalidating it with the instance.
+func (g *GTSClient) cacheTTL(requestURL string) time.Duration {
+	var seconds int
+
+	switch resourceType(requestURL) {
+	case resourceTypeAccounts:
+		seconds = g.cacheTTLs.Accounts
+	case resourceTypeInstance:
+		seconds = g.cacheTTLs.Instance
+	case resourceTypeLists:
+		seconds = g.cacheTTLs.Lists
+	default:
+		seconds = g.cacheTTLs.Default
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// resourceType returns the type of resource that the API endpoint belongs to.
+// Only the endpoints for a single account or list belong to the accounts and
+// lists types; their sub-collections (e.g. the statuses or followers of an
+// account) change more often and belong to the other type.
+func resourceType(requestURL string) string {
+	parsed, err := url.Parse(requestURL)
+	if err != nil {
+		return resourceTypeOther
+	}
+
+	// API paths are in the form of /api/<version>/<resource>/...
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) < 3 || segments[0] != "api" {
+		return resourceTypeOther
+	}
+
+	switch segments[2] {
+	case resourceTypeInstance:
+		return resourceTypeInstance
+	case resourceTypeAccounts:
+		// /api/v1/accounts/:id, /api/v1/accounts/lookup and /api/v1/accounts/verify_credentials
+		if len(segments) == 4 && !slices.Contains(accountCollections, segments[3]) {
+			return resourceTypeAccounts
+		}
+	case resourceTypeLists:
+		// /api/v1/lists and /api/v1/lists/:id
+		if len(segments) <= 4 {
+			return resourceTypeLists
+		}
+	}
+
+	return resourceTypeOther
+}
+
+// GetResponseCache returns the summary of the cached responses for your account.
+func (g *GTSClient) GetResponseCache(_ NoRPCArgs, summary *model.ResponseCache) error {
+	cache, enabled, err := g.responseCache()
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		return ResponseCacheDisabledError{}
+	}
+
+	entries, err := cache.Entries()
+	if err != nil {
+		return fmt.Errorf("unable to read the cached responses: %w", err)
+	}
+
+	responses := make([]model.CachedResponse, len(entries))
+
+	for idx, entry := range entries {
+		var expiresAt time.Time
+		if ttl := g.cacheTTL(entry.URL); ttl > 0 {
+			expiresAt = entry.StoredAt.Add(ttl)
+		}
+
+		responses[idx] = model.CachedResponse{
+			URL:          entry.URL,
+			ResourceType: resourceType(entry.URL),
+			Size:         entry.Size,
+			StoredAt:     entry.StoredAt,
+			ExpiresAt:    expiresAt,
+		}
+	}
+
+	slices.SortFunc(responses, func(a, b model.CachedResponse) int {
+		return strings.Compare(a.URL, b.URL)
+	})
+
+	*summary = model.ResponseCache{
+		Directory: cache.Dir(),
+		Entries:   responses,
+	}
+
+	return nil
+}
+
+// ClearResponseCache removes the cached responses for your account
+// and returns the number of responses removed.
+func (g *GTSClient) ClearResponseCache(_ NoRPCArgs, removed *int) error {
+	cache, enabled, err := g.responseCache()
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		return ResponseCacheDisabledError{}
+	}
+
+	count, err := cache.Clear()
+	if err != nil {
+		return fmt.Errorf("unable to clear the cached responses: %w", err)
+	}
+
+	*removed = count
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package gtsclient_test
+
+import (
+	"testing"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+)
+
+func TestResourceType(t *testing.T) {
+	t.Parallel()
+
+	const instance = "https://gts.example.org"
+
+	testCases := []struct {
+		path string
+		want string
+	}{
+		{path: "/api/v1/accounts/01J1TR3EHWG8EZEPBPFMB9F1RW", want: "accounts"},
+		{path: "/api/v1/accounts/lookup?acct=alice", want: "accounts"},
+		{path: "/api/v1/accounts/verify_credentials", want: "accounts"},
+		{path: "/api/v1/accounts/01J1TR3EHWG8EZEPBPFMB9F1RW/statuses?limit=20", want: "other"},
+		{path: "/api/v1/accounts/01J1TR3EHWG8EZEPBPFMB9F1RW/followers", want: "other"},
+		{path: "/api/v1/accounts/01J1TR3EHWG8EZEPBPFMB9F1RW/following", want: "other"},
+		{path: "/api/v1/accounts/relationships?id[]=01J1TR3EHWG8EZEPBPFMB9F1RW", want: "other"},
+		{path: "/api/v1/accounts/search?q=alice", want: "other"},
+		{path: "/api/v1/lists", want: "lists"},
+		{path: "/api/v1/lists/01J1TR3EHWG8EZEPBPFMB9F1RW", want: "lists"},
+		{path: "/api/v1/lists/01J1TR3EHWG8EZEPBPFMB9F1RW/accounts", want: "other"},
+		{path: "/api/v1/instance", want: "instance"},
+		{path: "/api/v2/instance", want: "instance"},
+		{path: "/api/v1/instance/rules", want: "instance"},
+		{path: "/api/v1/timelines/home", want: "other"},
+		{path: "/nodeinfo/2.0", want: "other"},
+	}
+
+	for _, tc := range testCases {
+		if got := gtsclient.ResourceType(instance + tc.path); got != tc.want {
+			t.Errorf("Unexpected resource type for %s: want %s, got %s", tc.path, tc.want, got)
+		}
+	}
+}
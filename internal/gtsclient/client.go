@@ -3,6 +3,7 @@ package gtsclient
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
@@ -18,11 +19,18 @@ type (
 	NoRPCResults struct{}
 
 	GTSClient struct {
-		auth         *auth.Auth
-		httpClient   http.Client
-		timeout      time.Duration
-		mediaTimeout time.Duration
-		userAgent    string
+		auth           *auth.Auth
+		httpClient     http.Client
+		timeout        time.Duration
+		mediaTimeout   time.Duration
+		userAgent      string
+		streamsMu      sync.Mutex
+		streams        map[string]*stream
+		rateLimits     *rateLimits
+		memoryCache    *memoryCache
+		cacheRoot      string
+		cacheResponses bool
+		cacheTTLs      config.CacheTTL
 	}
 )
 
@@ -50,11 +58,18 @@ func NewGTSClient(cfg config.Config) (*GTSClient, error) {
 	}
 
 	gtsClient := GTSClient{
-		auth:         newAuth,
-		httpClient:   http.Client{},
-		timeout:      time.Duration(cfg.GTSClient.Timeout) * time.Second,
-		mediaTimeout: time.Duration(cfg.GTSClient.MediaTimeout) * time.Second,
-		userAgent:    info.ApplicationTitledName + "/" + info.BinaryVersion,
+		auth:           newAuth,
+		httpClient:     http.Client{},
+		timeout:        time.Duration(cfg.GTSClient.Timeout) * time.Second,
+		mediaTimeout:   time.Duration(cfg.GTSClient.MediaTimeout) * time.Second,
+		userAgent:      info.ApplicationTitledName + "/" + info.BinaryVersion,
+		streamsMu:      sync.Mutex{},
+		streams:        make(map[string]*stream),
+		rateLimits:     newRateLimits(),
+		memoryCache:    newMemoryCache(),
+		cacheRoot:      cfg.CacheDirectory,
+		cacheResponses: cfg.GTSClient.CacheResponses,
+		cacheTTLs:      cfg.GTSClient.CacheTTL,
 	}
 
 	return &gtsClient, nil
@@ -64,5 +79,8 @@ func NewGTSClient(cfg config.Config) (*GTSClient, error) {
 func (g *GTSClient) UpdateAuthentication(authCfg config.Credentials, _ *NoRPCResults) error {
 	g.auth.UpdateAuth(authCfg)
 
+	// The cached relationships and statuses are specific to the account that was in use.
+	g.memoryCache.purge()
+
 	return nil
 }
@@ -0,0 +1,105 @@
+package gtsclient
+
+import (
+	"fmt"
+	"net/http"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const baseConversationsPath string = "/api/v1/conversations"
+
+func (g *GTSClient) GetConversationList(args PaginationArgs, list *model.ConversationList) error {
+	conversations, pagination, err := getPaginatedList[model.Conversation](
+		g,
+		baseConversationsPath,
+		"",
+		args,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the list of conversations: %w",
+			err,
+		)
+	}
+
+	*list = model.ConversationList{
+		Name:          "Your conversations",
+		Conversations: conversations,
+		Pagination:    pagination,
+	}
+
+	return nil
+}
+
+// GetConversation retrieves the conversation with the specified ID. The API does not
+// provide an endpoint for retrieving a single conversation so the conversation is
+// searched for in the full list of conversations.
+func (g *GTSClient) GetConversation(conversationID string, conversation *model.Conversation) error {
+	conversations, _, err := getPaginatedList[model.Conversation](
+		g,
+		baseConversationsPath,
+		"",
+		PaginationArgs{
+			Limit:   40,
+			MaxID:   "",
+			MinID:   "",
+			SinceID: "",
+			All:     true,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the list of conversations: %w",
+			err,
+		)
+	}
+
+	for idx := range conversations {
+		if conversations[idx].ID == conversationID {
+			*conversation = conversations[idx]
+
+			return nil
+		}
+	}
+
+	return ConversationNotFoundError{conversationID: conversationID}
+}
+
+func (g *GTSClient) MarkConversationAsRead(conversationID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseConversationsPath + "/" + conversationID + "/read",
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to mark the conversation as read: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) DeleteConversation(conversationID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodDelete,
+		url:         g.auth.GetInstanceURL() + baseConversationsPath + "/" + conversationID,
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to delete the conversation: %w",
+			err,
+		)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package gtsclient
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type ResponseError struct {
 	StatusCode       int
@@ -47,3 +50,58 @@ type EmptyAccessTokenError struct{}
 func (e EmptyAccessTokenError) Error() string {
 	return "received an empty access token"
 }
+
+type InvalidLinkHeaderError struct {
+	header string
+}
+
+func (e InvalidLinkHeaderError) Error() string {
+	return "unable to parse the Link header from the response: " + e.header
+}
+
+type StreamNotFoundError struct {
+	streamID string
+}
+
+func (e StreamNotFoundError) Error() string {
+	return "unable to find the stream with ID " + e.streamID
+}
+
+type StreamClosedError struct {
+	streamID string
+}
+
+func (e StreamClosedError) Error() string {
+	return "the stream with ID " + e.streamID + " is closed"
+}
+
+type UnsupportedURLSchemeError struct {
+	scheme string
+}
+
+func (e UnsupportedURLSchemeError) Error() string {
+	return "unsupported URL scheme: " + e.scheme
+}
+
+type ConversationNotFoundError struct {
+	conversationID string
+}
+
+func (e ConversationNotFoundError) Error() string {
+	return "unable to find the conversation with ID " + e.conversationID
+}
+
+type RateLimitExceededError struct {
+	instance string
+	reset    time.Time
+}
+
+func (e RateLimitExceededError) Error() string {
+	return "the rate limit for " + e.instance + " is exhausted until " + e.reset.Local().Format(time.DateTime)
+}
+
+type ResponseCacheDisabledError struct{}
+
+func (e ResponseCacheDisabledError) Error() string {
+	return "the response cache is disabled in your configuration"
+}
@@ -0,0 +1,9 @@
+package gtsclient
+
+// The following unexported functions are exported for the tests in the gtsclient_test package.
+var (
+	ParseResetTime = parseResetTime
+	ResourceType   = resourceType
+	RetryDelay     = retryDelay
+	Retryable      = retryable
+)
@@ -0,0 +1,76 @@
+package gtsclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const baseInteractionPoliciesPath string = "/api/v1/interaction_policies"
+
+// InteractionPolicyForm is the interaction policy of a new status. The instance
+// uses the default rules for the actions that are not specified.
+type InteractionPolicyForm struct {
+	CanFavourite *model.PolicyRules `json:"can_favourite,omitempty"`
+	CanReblog    *model.PolicyRules `json:"can_reblog,omitempty"`
+	CanReply     *model.PolicyRules `json:"can_reply,omitempty"`
+}
+
+// UpdateDefaultInteractionPoliciesForm is the form for updating the default
+// interaction policies. The policy for each visibility that is not specified
+// is reset to the instance's default policy.
+type UpdateDefaultInteractionPoliciesForm struct {
+	Direct   *model.InteractionPolicy `json:"direct,omitempty"`
+	Private  *model.InteractionPolicy `json:"private,omitempty"`
+	Public   *model.InteractionPolicy `json:"public,omitempty"`
+	Unlisted *model.InteractionPolicy `json:"unlisted,omitempty"`
+}
+
+func (g *GTSClient) GetDefaultInteractionPolicies(_ NoRPCArgs, policies *model.DefaultInteractionPolicies) error {
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseInteractionPoliciesPath + "/defaults",
+		requestBody: nil,
+		contentType: "",
+		output:      policies,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the default interaction policies: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) UpdateDefaultInteractionPolicies(
+	form UpdateDefaultInteractionPoliciesForm,
+	policies *model.DefaultInteractionPolicies,
+) error {
+	data, err := json.Marshal(form)
+	if err != nil {
+		return fmt.Errorf("unable to create the JSON form: %w", err)
+	}
+
+	params := requestParameters{
+		httpMethod:  http.MethodPatch,
+		url:         g.auth.GetInstanceURL() + baseInteractionPoliciesPath + "/defaults",
+		requestBody: bytes.NewBuffer(data),
+		contentType: applicationJSON,
+		output:      policies,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to update the default interaction policies: %w",
+			err,
+		)
+	}
+
+	return nil
+}
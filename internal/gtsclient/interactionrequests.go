@@ -0,0 +1,120 @@
+package gtsclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const baseInteractionRequestsPath string = "/api/v1/interaction_requests"
+
+// GetInteractionRequestListArgs is the set of arguments for retrieving the
+// list of pending interaction requests. All types of interaction requests
+// are included if Types is empty.
+type GetInteractionRequestListArgs struct {
+	Pagination PaginationArgs
+	StatusID   string
+	Types      []string
+}
+
+func (g *GTSClient) GetInteractionRequestList(
+	args GetInteractionRequestListArgs,
+	list *model.InteractionRequestList,
+) error {
+	query := make([]string, 0, 4)
+
+	if args.StatusID != "" {
+		query = append(query, "status_id="+url.QueryEscape(args.StatusID))
+	}
+
+	if len(args.Types) > 0 {
+		query = append(
+			query,
+			fmt.Sprintf("favourites=%t", slices.Contains(args.Types, "favourite")),
+			fmt.Sprintf("reblogs=%t", slices.Contains(args.Types, "reblog")),
+			fmt.Sprintf("replies=%t", slices.Contains(args.Types, "reply")),
+		)
+	}
+
+	requests, pagination, err := getPaginatedList[model.InteractionRequest](
+		g,
+		baseInteractionRequestsPath,
+		strings.Join(query, "&"),
+		args.Pagination,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the list of interaction requests: %w",
+			err,
+		)
+	}
+
+	*list = model.InteractionRequestList{
+		Name:                "Pending interaction requests",
+		InteractionRequests: requests,
+		Pagination:          pagination,
+	}
+
+	return nil
+}
+
+func (g *GTSClient) GetInteractionRequest(requestID string, request *model.InteractionRequest) error {
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseInteractionRequestsPath + "/" + requestID,
+		requestBody: nil,
+		contentType: "",
+		output:      request,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the interaction request: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) AcceptInteractionRequest(requestID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseInteractionRequestsPath + "/" + requestID + "/authorize",
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to accept the interaction request: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) RejectInteractionRequest(requestID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseInteractionRequestsPath + "/" + requestID + "/reject",
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to reject the interaction request: %w",
+			err,
+		)
+	}
+
+	return nil
+}
@@ -221,24 +221,18 @@ func (g *GTSClient) RemoveAccountsFromList(args RemoveAccountsFromListArgs, _ *N
 }
 
 type GetAccountsFromListArgs struct {
-	ListID string
-	Limit  int
+	ListID     string
+	Pagination PaginationArgs
 }
 
 func (g *GTSClient) GetAccountsFromList(args GetAccountsFromListArgs, list *model.AccountList) error {
-	path := fmt.Sprintf("%s/%s/accounts?limit=%d", baseListPath, args.ListID, args.Limit)
-
-	var accounts []model.Account
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + path,
-		requestBody: nil,
-		contentType: "",
-		output:      &accounts,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+	accounts, pagination, err := getPaginatedList[model.Account](
+		g,
+		baseListPath+"/"+args.ListID+"/accounts",
+		"",
+		args.Pagination,
+	)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the accounts from the list: %w",
 			err,
@@ -249,6 +243,7 @@ func (g *GTSClient) GetAccountsFromList(args GetAccountsFromListArgs, list *mode
 		Label:           "Accounts",
 		Accounts:        accounts,
 		BlockedAccounts: false,
+		Pagination:      pagination,
 	}
 
 	return nil
@@ -0,0 +1,80 @@
+package gtsclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const (
+	baseMarkersPath string = "/api/v1/markers"
+
+	MarkerHome          string = "home"
+	MarkerNotifications string = "notifications"
+)
+
+func (g *GTSClient) GetMarkers(_ NoRPCArgs, markers *model.Markers) error {
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseMarkersPath + "?timeline[]=" + MarkerHome + "&timeline[]=" + MarkerNotifications,
+		requestBody: nil,
+		contentType: "",
+		output:      markers,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the markers: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+type UpdateMarkerArgs struct {
+	Timeline   string
+	LastReadID string
+}
+
+func (g *GTSClient) UpdateMarker(args UpdateMarkerArgs, marker *model.Marker) error {
+	form := map[string]struct {
+		LastReadID string `json:"last_read_id"`
+	}{
+		args.Timeline: {LastReadID: args.LastReadID},
+	}
+
+	data, err := json.Marshal(form)
+	if err != nil {
+		return fmt.Errorf("unable to create the JSON form: %w", err)
+	}
+
+	var markers model.Markers
+
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseMarkersPath,
+		requestBody: bytes.NewBuffer(data),
+		contentType: applicationJSON,
+		output:      &markers,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to update the %s marker: %w",
+			args.Timeline,
+			err,
+		)
+	}
+
+	if args.Timeline == MarkerHome {
+		*marker = markers.Home
+	} else {
+		*marker = markers.Notifications
+	}
+
+	return nil
+}
@@ -41,60 +41,25 @@ type CreateMediaAttachmentArgs struct {
 }
 
 func (g *GTSClient) CreateMediaAttachment(args CreateMediaAttachmentArgs, attachment *model.MediaAttachment) error {
-	file, err := utilities.OpenFile(args.Path)
-	if err != nil {
-		return fmt.Errorf("unable to open the file: %w", err)
-	}
-	defer file.Close()
-
 	// create the request body using a writer from the multipart package
 	requestBody := bytes.Buffer{}
 	requestBodyWriter := multipart.NewWriter(&requestBody)
 
-	filename := filepath.Base(args.Path)
-
-	part, err := requestBodyWriter.CreateFormFile("file", filename)
-	if err != nil {
-		return fmt.Errorf("unable to create the new part: %w", err)
-	}
-
-	if _, err := io.Copy(part, file); err != nil {
-		return fmt.Errorf("unable to copy the file contents to the form: %w", err)
+	if err := writeFormFile(requestBodyWriter, "file", args.Path); err != nil {
+		return err
 	}
 
 	// add the description
 	if args.Description != "" {
-		descriptionFormFieldWriter, err := requestBodyWriter.CreateFormField("description")
-		if err != nil {
-			return fmt.Errorf(
-				"unable to create the writer for the 'description' form field: %w",
-				err,
-			)
-		}
-
-		if _, err := io.WriteString(descriptionFormFieldWriter, args.Description); err != nil {
-			return fmt.Errorf(
-				"unable to write the description to the form: %w",
-				err,
-			)
+		if err := writeFormField(requestBodyWriter, "description", args.Description); err != nil {
+			return err
 		}
 	}
 
 	// add the focus values
 	if args.Focus != "" {
-		focusFormFieldWriter, err := requestBodyWriter.CreateFormField("focus")
-		if err != nil {
-			return fmt.Errorf(
-				"unable to create the writer for the 'focus' form field: %w",
-				err,
-			)
-		}
-
-		if _, err := io.WriteString(focusFormFieldWriter, args.Focus); err != nil {
-			return fmt.Errorf(
-				"unable to write the focus values to the form: %w",
-				err,
-			)
+		if err := writeFormField(requestBodyWriter, "focus", args.Focus); err != nil {
+			return err
 		}
 	}
 
@@ -208,3 +173,46 @@ func (g *GTSClient) GetInstanceURL(_ NoRPCArgs, url *string) error {
 
 	return nil
 }
+
+// writeFormFile copies the contents of the file at the specified path
+// to a new file part of the multipart form.
+func writeFormFile(writer *multipart.Writer, fieldName, path string) error {
+	file, err := utilities.OpenFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to open the file: %w", err)
+	}
+	defer file.Close()
+
+	part, err := writer.CreateFormFile(fieldName, filepath.Base(path))
+	if err != nil {
+		return fmt.Errorf("unable to create the new part: %w", err)
+	}
+
+	if _, err := io.Copy(part, file); err != nil {
+		return fmt.Errorf("unable to copy the file contents to the form: %w", err)
+	}
+
+	return nil
+}
+
+// writeFormField writes the value to a new field of the multipart form.
+func writeFormField(writer *multipart.Writer, fieldName, value string) error {
+	fieldWriter, err := writer.CreateFormField(fieldName)
+	if err != nil {
+		return fmt.Errorf(
+			"unable to create the writer for the '%s' form field: %w",
+			fieldName,
+			err,
+		)
+	}
+
+	if _, err := io.WriteString(fieldWriter, value); err != nil {
+		return fmt.Errorf(
+			"unable to write the value of the '%s' form field: %w",
+			fieldName,
+			err,
+		)
+	}
+
+	return nil
+}
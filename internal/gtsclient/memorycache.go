@@ -0,0 +1,100 @@
+package gtsclient
+
+import (
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/lru"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const (
+	accountCacheCapacity      int = 500
+	relationshipCacheCapacity int = 500
+	statusCacheCapacity       int = 1000
+
+	// memoryCacheMaxAge is how long the accounts, relationships and statuses are kept
+	// in memory so that changes made elsewhere (e.g. from the web client) are eventually seen.
+	memoryCacheMaxAge = 5 * time.Minute
+)
+
+// memoryCache holds the accounts, relationships and statuses retrieved from the instance
+// for the lifetime of the server process. The entries are removed whenever we make
+// a change to them so that the cache is never out of date with our own actions.
+type memoryCache struct {
+	accounts      *lru.Cache[string, model.Account]
+	relationships *lru.Cache[string, model.AccountRelationship]
+	statuses      *lru.Cache[string, model.Status]
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{
+		accounts:      lru.New[string, model.Account](accountCacheCapacity, memoryCacheMaxAge),
+		relationships: lru.New[string, model.AccountRelationship](relationshipCacheCapacity, memoryCacheMaxAge),
+		statuses:      lru.New[string, model.Status](statusCacheCapacity, memoryCacheMaxAge),
+	}
+}
+
+// accountKey returns the key of the account in the cache. Account URIs are
+// case-insensitive and may be written with or without the leading '@'.
+func accountKey(accountURI string) string {
+	return strings.ToLower(strings.TrimPrefix(accountURI, "@"))
+}
+
+// invalidateAccount removes the account and our relationship with it from the cache.
+func (m *memoryCache) invalidateAccount(accountID string) {
+	m.relationships.Remove(accountID)
+	m.accounts.RemoveFunc(func(_ string, account model.Account) bool {
+		return account.ID == accountID
+	})
+}
+
+// invalidateStatus removes the status and any boosts of it from the cache.
+func (m *memoryCache) invalidateStatus(statusID string) {
+	m.statuses.RemoveFunc(func(id string, status model.Status) bool {
+		return id == statusID || status.Reblog.ID == statusID
+	})
+}
+
+// invalidatePoll removes the statuses containing the poll from the cache.
+func (m *memoryCache) invalidatePoll(pollID string) {
+	m.statuses.RemoveFunc(func(_ string, status model.Status) bool {
+		return status.Poll.ID == pollID || status.Reblog.Poll.ID == pollID
+	})
+}
+
+// purge removes everything from the cache.
+func (m *memoryCache) purge() {
+	m.accounts.Purge()
+	m.relationships.Purge()
+	m.statuses.Purge()
+}
+
+// GetMemoryCacheStats returns the usage of the in-memory caches.
+func (g *GTSClient) GetMemoryCacheStats(_ NoRPCArgs, stats *model.MemoryCacheStats) error {
+	caches := []struct {
+		name  string
+		stats lru.Stats
+	}{
+		{name: "accounts", stats: g.memoryCache.accounts.Stats()},
+		{name: "relationships", stats: g.memoryCache.relationships.Stats()},
+		{name: "statuses", stats: g.memoryCache.statuses.Stats()},
+	}
+
+	output := make([]model.CacheStats, len(caches))
+
+	for idx, cache := range caches {
+		output[idx] = model.CacheStats{
+			Name:      cache.name,
+			Size:      cache.stats.Size,
+			Capacity:  cache.stats.Capacity,
+			Hits:      cache.stats.Hits,
+			Misses:    cache.stats.Misses,
+			Evictions: cache.stats.Evictions,
+		}
+	}
+
+	*stats = model.MemoryCacheStats{Caches: output}
+
+	return nil
+}
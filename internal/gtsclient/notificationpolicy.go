@@ -0,0 +1,158 @@
+package gtsclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const (
+	baseNotificationPolicyPath   string = "/api/v2/notifications/policy"
+	baseNotificationRequestsPath string = "/api/v1/notifications/requests"
+)
+
+// UpdateNotificationPolicyForm is the form for updating the notification policy.
+// The policies that are not specified are left unchanged.
+type UpdateNotificationPolicyForm struct {
+	ForNotFollowing    string `json:"for_not_following,omitempty"`
+	ForNotFollowers    string `json:"for_not_followers,omitempty"`
+	ForNewAccounts     string `json:"for_new_accounts,omitempty"`
+	ForPrivateMentions string `json:"for_private_mentions,omitempty"`
+	ForLimitedAccounts string `json:"for_limited_accounts,omitempty"`
+}
+
+// IsZero returns true if none of the policies are specified.
+func (f UpdateNotificationPolicyForm) IsZero() bool {
+	return f == UpdateNotificationPolicyForm{}
+}
+
+func (g *GTSClient) GetNotificationPolicy(_ NoRPCArgs, policy *model.NotificationPolicy) error {
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseNotificationPolicyPath,
+		requestBody: nil,
+		contentType: "",
+		output:      policy,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the notification policy: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) UpdateNotificationPolicy(
+	form UpdateNotificationPolicyForm,
+	policy *model.NotificationPolicy,
+) error {
+	data, err := json.Marshal(form)
+	if err != nil {
+		return fmt.Errorf("unable to create the JSON form: %w", err)
+	}
+
+	params := requestParameters{
+		httpMethod:  http.MethodPatch,
+		url:         g.auth.GetInstanceURL() + baseNotificationPolicyPath,
+		requestBody: bytes.NewBuffer(data),
+		contentType: applicationJSON,
+		output:      policy,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to update the notification policy: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) GetNotificationRequestList(
+	args PaginationArgs,
+	list *model.NotificationRequestList,
+) error {
+	requests, pagination, err := getPaginatedList[model.NotificationRequest](
+		g,
+		baseNotificationRequestsPath,
+		"",
+		args,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the list of notification requests: %w",
+			err,
+		)
+	}
+
+	*list = model.NotificationRequestList{
+		Requests:   requests,
+		Pagination: pagination,
+	}
+
+	return nil
+}
+
+func (g *GTSClient) GetNotificationRequest(requestID string, request *model.NotificationRequest) error {
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseNotificationRequestsPath + "/" + requestID,
+		requestBody: nil,
+		contentType: "",
+		output:      request,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the notification request: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) AcceptNotificationRequest(requestID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseNotificationRequestsPath + "/" + requestID + "/accept",
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to accept the notification request: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) DismissNotificationRequest(requestID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseNotificationRequestsPath + "/" + requestID + "/dismiss",
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to dismiss the notification request: %w",
+			err,
+		)
+	}
+
+	return nil
+}
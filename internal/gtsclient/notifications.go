@@ -3,6 +3,7 @@ package gtsclient
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 )
@@ -29,33 +30,55 @@ func (g *GTSClient) GetNotification(notificationID string, notification *model.N
 }
 
 type GetNotificationListArgs struct {
-	Limit        int
+	Pagination   PaginationArgs
 	IncludeTypes []string
 	ExcludeTypes []string
 }
 
-func (g *GTSClient) GetNotificationList(args GetNotificationListArgs, notifications *[]model.Notification) error {
-	query := fmt.Sprintf("?limit=%d", args.Limit)
+func (g *GTSClient) GetNotificationList(args GetNotificationListArgs, list *model.NotificationList) error {
+	query := make([]string, 0, len(args.IncludeTypes)+len(args.ExcludeTypes))
 
 	for _, include := range args.IncludeTypes {
-		query = query + "&types[]=" + include
+		query = append(query, "types[]="+include)
 	}
 
 	for _, exclude := range args.ExcludeTypes {
-		query = query + "&exclude_types[]=" + exclude
+		query = append(query, "exclude_types[]="+exclude)
 	}
 
+	notifications, pagination, err := getPaginatedList[model.Notification](
+		g,
+		baseNotificationsPath,
+		strings.Join(query, "&"),
+		args.Pagination,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the list of notifications: %w",
+			err,
+		)
+	}
+
+	*list = model.NotificationList{
+		Notifications: notifications,
+		Pagination:    pagination,
+	}
+
+	return nil
+}
+
+func (g *GTSClient) DeleteNotifications(_ NoRPCArgs, _ *NoRPCResults) error {
 	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + baseNotificationsPath + query,
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseNotificationsPath + "/clear",
 		requestBody: nil,
 		contentType: "",
-		output:      &notifications,
+		output:      nil,
 	}
 
 	if err := g.sendRequest(params); err != nil {
 		return fmt.Errorf(
-			"received an error after sending the request to get the list of notifications: %w",
+			"received an error after sending the request to delete the notifications: %w",
 			err,
 		)
 	}
@@ -63,10 +86,10 @@ func (g *GTSClient) GetNotificationList(args GetNotificationListArgs, notificati
 	return nil
 }
 
-func (g *GTSClient) DeleteNotifications(_ NoRPCArgs, _ *NoRPCResults) error {
+func (g *GTSClient) DismissNotification(notificationID string, _ *NoRPCResults) error {
 	params := requestParameters{
 		httpMethod:  http.MethodPost,
-		url:         g.auth.GetInstanceURL() + baseNotificationsPath + "/clear",
+		url:         g.auth.GetInstanceURL() + baseNotificationsPath + "/" + notificationID + "/dismiss",
 		requestBody: nil,
 		contentType: "",
 		output:      nil,
@@ -74,7 +97,7 @@ func (g *GTSClient) DeleteNotifications(_ NoRPCArgs, _ *NoRPCResults) error {
 
 	if err := g.sendRequest(params); err != nil {
 		return fmt.Errorf(
-			"received an error after sending the request to delete the notifications: %w",
+			"received an error after sending the request to dismiss the notification: %w",
 			err,
 		)
 	}
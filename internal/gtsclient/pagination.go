@@ -0,0 +1,152 @@
+package gtsclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+// PaginationArgs is the set of arguments used to retrieve a page
+// from a paginated list. If All is set to true then every page after
+// the requested page is retrieved until the end of the list is reached.
+type PaginationArgs struct {
+	Limit   int
+	MaxID   string
+	MinID   string
+	SinceID string
+	All     bool
+}
+
+func (p PaginationArgs) query() string {
+	query := "limit=" + strconv.Itoa(p.Limit)
+
+	if p.MaxID != "" {
+		query += "&max_id=" + url.QueryEscape(p.MaxID)
+	}
+
+	if p.MinID != "" {
+		query += "&min_id=" + url.QueryEscape(p.MinID)
+	}
+
+	if p.SinceID != "" {
+		query += "&since_id=" + url.QueryEscape(p.SinceID)
+	}
+
+	return query
+}
+
+// getPaginatedList retrieves the items of a paginated list from the instance.
+// The path must not contain the pagination parameters; any other query
+// parameters can be specified with extraQuery (e.g. "exclude_replies=true").
+func getPaginatedList[T any](
+	g *GTSClient,
+	path string,
+	extraQuery string,
+	args PaginationArgs,
+) ([]T, model.Pagination, error) {
+	var (
+		items      []T
+		pagination model.Pagination
+		firstPage  = true
+	)
+
+	for {
+		var (
+			page      []T
+			pageLinks model.Pagination
+		)
+
+		query := "?" + args.query()
+		if extraQuery != "" {
+			query += "&" + extraQuery
+		}
+
+		params := requestParameters{
+			httpMethod:  http.MethodGet,
+			url:         g.auth.GetInstanceURL() + path + query,
+			requestBody: nil,
+			contentType: "",
+			output:      &page,
+			pagination:  &pageLinks,
+		}
+
+		if err := g.sendRequest(params); err != nil {
+			return nil, model.Pagination{}, err
+		}
+
+		items = append(items, page...)
+
+		// Keep the link to the newer items from the first page
+		// and the link to the older items from the last page.
+		if firstPage {
+			pagination.PrevMinID = pageLinks.PrevMinID
+			firstPage = false
+		}
+
+		pagination.NextMaxID = pageLinks.NextMaxID
+
+		if !args.All || len(page) == 0 || !pageLinks.HasNextPage() {
+			break
+		}
+
+		// The since_id stays as the lower bound of the list while
+		// the older pages are retrieved with max_id.
+		args.MaxID = pageLinks.NextMaxID
+		args.MinID = ""
+	}
+
+	return items, pagination, nil
+}
+
+// parseLinkHeader parses the Link header from a paginated response and
+// extracts the IDs used to retrieve the next and previous pages.
+// See https://docs.gotosocial.org/en/latest/api/pagination/ for more details.
+func parseLinkHeader(header string) (model.Pagination, error) {
+	var pagination model.Pagination
+
+	if header == "" {
+		return pagination, nil
+	}
+
+	for _, link := range strings.Split(header, ",") {
+		segments := strings.Split(link, ";")
+		if len(segments) < 2 {
+			return model.Pagination{}, InvalidLinkHeaderError{header: header}
+		}
+
+		rawURL := strings.TrimSpace(segments[0])
+		if !strings.HasPrefix(rawURL, "<") || !strings.HasSuffix(rawURL, ">") {
+			return model.Pagination{}, InvalidLinkHeaderError{header: header}
+		}
+
+		linkURL, err := url.Parse(strings.Trim(rawURL, "<>"))
+		if err != nil {
+			return model.Pagination{}, fmt.Errorf("unable to parse the URL in the Link header: %w", err)
+		}
+
+		var rel string
+
+		for _, param := range segments[1:] {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if found && key == "rel" {
+				rel = strings.Trim(value, `"`)
+			}
+		}
+
+		switch rel {
+		case "next":
+			pagination.NextMaxID = linkURL.Query().Get("max_id")
+		case "prev":
+			pagination.PrevMinID = linkURL.Query().Get("min_id")
+			if pagination.PrevMinID == "" {
+				pagination.PrevMinID = linkURL.Query().Get("since_id")
+			}
+		}
+	}
+
+	return pagination, nil
+}
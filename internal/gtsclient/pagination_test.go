@@ -0,0 +1,113 @@
+package gtsclient_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+func TestGetPaginatedListKeepsSinceID(t *testing.T) {
+	t.Parallel()
+
+	// The pages of the bookmarks, from the newest to the oldest.
+	pages := map[string][]string{
+		"":   {"05", "04"},
+		"04": {"03", "02"},
+		"02": {},
+	}
+
+	var (
+		mu       sync.Mutex
+		sinceIDs []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		mu.Lock()
+		sinceIDs = append(sinceIDs, query.Get("since_id"))
+		mu.Unlock()
+
+		page := pages[query.Get("max_id")]
+		statuses := make([]model.Status, len(page))
+
+		for idx := range page {
+			statuses[idx].ID = page[idx]
+		}
+
+		if len(page) > 0 {
+			w.Header().Set(
+				"Link",
+				"<http://"+r.Host+r.URL.Path+"?max_id="+page[len(page)-1]+">; rel=\"next\"",
+			)
+		}
+
+		_ = json.NewEncoder(w).Encode(statuses)
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(t, server.URL)
+
+	var bookmarks model.StatusList
+
+	args := gtsclient.PaginationArgs{
+		Limit:   2,
+		MaxID:   "",
+		MinID:   "",
+		SinceID: "01",
+		All:     true,
+	}
+
+	if err := client.GetBookmarks(args, &bookmarks); err != nil {
+		t.Fatalf("Unable to get the bookmarks: %v", err)
+	}
+
+	if len(bookmarks.Statuses) != 4 {
+		t.Errorf("Unexpected number of bookmarks: want 4, got %d", len(bookmarks.Statuses))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if want := []string{"01", "01", "01"}; !slices.Equal(sinceIDs, want) {
+		t.Errorf("Unexpected since_id values sent: want %v, got %v", want, sinceIDs)
+	}
+}
+
+// newTestClient returns a client for the test instance at instanceURL.
+func newTestClient(t *testing.T, instanceURL string) *gtsclient.GTSClient {
+	t.Helper()
+
+	var cfg config.Config
+
+	cfg.CredentialsFile = filepath.Join(t.TempDir(), "credentials.json")
+	cfg.CacheDirectory = t.TempDir()
+	cfg.GTSClient.Timeout = 5
+	cfg.GTSClient.MediaTimeout = 5
+
+	client, err := gtsclient.NewGTSClient(cfg)
+	if err != nil {
+		t.Fatalf("Unable to create the client: %v", err)
+	}
+
+	credentials := config.Credentials{
+		Instance:     instanceURL,
+		ClientID:     "",
+		ClientSecret: "",
+		AccessToken:  "token",
+	}
+
+	if err := client.UpdateAuthentication(credentials, &gtsclient.NoRPCResults{}); err != nil {
+		t.Fatalf("Unable to update the authentication details: %v", err)
+	}
+
+	return client
+}
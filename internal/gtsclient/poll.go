@@ -40,5 +40,7 @@ func (g *GTSClient) VoteInPoll(args VoteInPollArgs, _ *NoRPCResults) error {
 		return fmt.Errorf("received an error after sending the request to vote in the poll: %w", err)
 	}
 
+	g.memoryCache.invalidatePoll(args.PollID)
+
 	return nil
 }
@@ -0,0 +1,195 @@
+package gtsclient
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const (
+	// maxRetries is the maximum number of times a request is retried
+	// after a rate limited or unavailable response from the instance.
+	maxRetries int = 3
+
+	// baseRetryDelay is the delay before the first retry when the instance
+	// does not tell us how long to wait. It doubles after every attempt.
+	baseRetryDelay = time.Second
+
+	// maxRateLimitWait is the longest we are willing to wait for the rate limit
+	// to reset. GoToSocial resets its rate limit every 5 minutes by default.
+	maxRateLimitWait = 5 * time.Minute
+)
+
+// rateLimits keeps track of the rate limits reported by each instance.
+type rateLimits struct {
+	mu     sync.Mutex
+	limits map[string]model.RateLimit
+}
+
+func newRateLimits() *rateLimits {
+	return &rateLimits{
+		mu:     sync.Mutex{},
+		limits: make(map[string]model.RateLimit),
+	}
+}
+
+// get returns the last known rate limit for the instance.
+func (r *rateLimits) get(instance string) (model.RateLimit, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	limit, ok := r.limits[instance]
+
+	return limit, ok
+}
+
+// update records the rate limit from the headers of the instance's response.
+// The rate limit is left unchanged if the response does not include the headers.
+func (r *rateLimits) update(instance string, header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.limits[instance] = model.RateLimit{
+		Instance:  instance,
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     parseResetTime(header.Get("X-RateLimit-Reset")),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// waitTime returns how long we need to wait before sending a request
+// to the instance without being rate limited.
+func (r *rateLimits) waitTime(instance string) time.Duration {
+	limit, ok := r.get(instance)
+	if !ok || !limit.Exhausted() {
+		return 0
+	}
+
+	return time.Until(limit.Reset)
+}
+
+// parseResetTime parses the value of the X-RateLimit-Reset header.
+// GoToSocial and Mastodon send an ISO 8601 timestamp but some
+// implementations send a Unix timestamp instead.
+func parseResetTime(value string) time.Time {
+	if reset, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return reset
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0)
+	}
+
+	return time.Time{}
+}
+
+// instanceHost returns the host of the request URL which is used to
+// identify the instance that the rate limit belongs to.
+func instanceHost(requestURL string) string {
+	parsed, err := url.Parse(requestURL)
+	if err != nil {
+		return requestURL
+	}
+
+	return parsed.Host
+}
+
+// retryable returns true if the request can be sent again after receiving the
+// specified status code. A rate limited request was rejected before it was processed
+// so it is always safe to retry it, otherwise only idempotent requests are retried.
+func retryable(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+}
+
+// retryDelay calculates how long to wait before retrying the request.
+// The Retry-After header is honoured when present. The X-RateLimit-Reset header is
+// only used for rate limited requests since the instance sends it with every response.
+// Otherwise the delay grows exponentially with each attempt. Jitter is added so that
+// concurrent requests do not all retry at the same time.
+func retryDelay(statusCode, attempt int, header http.Header) time.Duration {
+	var delay time.Duration
+
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if statusCode == http.StatusTooManyRequests {
+		if reset := parseResetTime(header.Get("X-RateLimit-Reset")); !reset.IsZero() {
+			delay = time.Until(reset)
+		}
+	}
+
+	if delay <= 0 {
+		delay = baseRetryDelay << attempt
+	}
+
+	return delay + rand.N(delay/2+1) //nolint:gosec
+}
+
+// GetRateLimit returns the last known rate limit for the instance that you are logged into.
+// If no requests have been sent to the instance yet then a request is sent
+// to retrieve the current rate limit.
+func (g *GTSClient) GetRateLimit(_ NoRPCArgs, rateLimit *model.RateLimit) error {
+	instanceURL := g.auth.GetInstanceURL()
+	instance := instanceHost(instanceURL)
+
+	if limit, ok := g.rateLimits.get(instance); ok {
+		*rateLimit = limit
+
+		return nil
+	}
+
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         instanceURL + instancePath,
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf("received an error after sending the request to get the rate limit: %w", err)
+	}
+
+	limit, ok := g.rateLimits.get(instance)
+	if !ok {
+		limit = model.RateLimit{
+			Instance:  instance,
+			Limit:     0,
+			Remaining: 0,
+			Reset:     time.Time{},
+			UpdatedAt: time.Now(),
+		}
+	}
+
+	*rateLimit = limit
+
+	return nil
+}
@@ -0,0 +1,362 @@
+package gtsclient_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+func TestRetryable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		method     string
+		statusCode int
+		want       bool
+	}{
+		{method: http.MethodGet, statusCode: http.StatusTooManyRequests, want: true},
+		{method: http.MethodPost, statusCode: http.StatusTooManyRequests, want: true},
+		{method: http.MethodGet, statusCode: http.StatusBadGateway, want: true},
+		{method: http.MethodDelete, statusCode: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodPut, statusCode: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodPost, statusCode: http.StatusBadGateway, want: false},
+		{method: http.MethodPatch, statusCode: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodGet, statusCode: http.StatusInternalServerError, want: false},
+		{method: http.MethodGet, statusCode: http.StatusNotFound, want: false},
+	}
+
+	for _, tc := range testCases {
+		if got := gtsclient.Retryable(tc.method, tc.statusCode); got != tc.want {
+			t.Errorf(
+				"Unexpected result for %s with status code %d: want %t, got %t",
+				tc.method,
+				tc.statusCode,
+				tc.want,
+				got,
+			)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	resetIn := func(duration time.Duration) string {
+		return time.Now().Add(duration).UTC().Format(time.RFC3339Nano)
+	}
+
+	testCases := []struct {
+		name       string
+		statusCode int
+		attempt    int
+		header     http.Header
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{
+			name:       "Rate limited with Retry-After",
+			statusCode: http.StatusTooManyRequests,
+			attempt:    0,
+			header:     http.Header{"Retry-After": []string{"10"}},
+			wantMin:    10 * time.Second,
+			wantMax:    15 * time.Second,
+		},
+		{
+			name:       "Rate limited with X-RateLimit-Reset",
+			statusCode: http.StatusTooManyRequests,
+			attempt:    0,
+			header:     http.Header{"X-Ratelimit-Reset": []string{resetIn(time.Minute)}},
+			wantMin:    50 * time.Second,
+			wantMax:    90 * time.Second,
+		},
+		{
+			name:       "Rate limited without headers",
+			statusCode: http.StatusTooManyRequests,
+			attempt:    1,
+			header:     http.Header{},
+			wantMin:    2 * time.Second,
+			wantMax:    3 * time.Second,
+		},
+		{
+			name:       "Unavailable ignores X-RateLimit-Reset",
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    0,
+			header:     http.Header{"X-Ratelimit-Reset": []string{resetIn(4 * time.Minute)}},
+			wantMin:    time.Second,
+			wantMax:    1500 * time.Millisecond,
+		},
+		{
+			name:       "Bad gateway backs off exponentially",
+			statusCode: http.StatusBadGateway,
+			attempt:    2,
+			header:     http.Header{"X-Ratelimit-Reset": []string{resetIn(4 * time.Minute)}},
+			wantMin:    4 * time.Second,
+			wantMax:    6 * time.Second,
+		},
+		{
+			name:       "Unavailable with Retry-After",
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    2,
+			header:     http.Header{"Retry-After": []string{"30"}},
+			wantMin:    30 * time.Second,
+			wantMax:    45 * time.Second,
+		},
+		{
+			name:       "Rate limit reset in the past",
+			statusCode: http.StatusTooManyRequests,
+			attempt:    0,
+			header:     http.Header{"X-Ratelimit-Reset": []string{resetIn(-time.Minute)}},
+			wantMin:    time.Second,
+			wantMax:    1500 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := gtsclient.RetryDelay(tc.statusCode, tc.attempt, tc.header)
+			if got < tc.wantMin || got > tc.wantMax {
+				t.Errorf("Unexpected delay: want between %s and %s, got %s", tc.wantMin, tc.wantMax, got)
+			}
+		})
+	}
+}
+
+func TestParseResetTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{
+			name:  "ISO 8601 timestamp",
+			value: "2026-10-18T09:30:00.123Z",
+			want:  time.Date(2026, time.October, 18, 9, 30, 0, 123000000, time.UTC),
+		},
+		{
+			name:  "ISO 8601 timestamp with offset",
+			value: "2026-10-18T10:30:00+01:00",
+			want:  time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "Unix timestamp",
+			value: "1792315800",
+			want:  time.Unix(1792315800, 0),
+		},
+		{
+			name:  "Empty value",
+			value: "",
+			want:  time.Time{},
+		},
+		{
+			name:  "Invalid value",
+			value: "tomorrow",
+			want:  time.Time{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := gtsclient.ParseResetTime(tc.value); !got.Equal(tc.want) {
+				t.Errorf("Unexpected reset time for %q: want %s, got %s", tc.value, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryRateLimitedRequest(t *testing.T) {
+	t.Parallel()
+
+	instance := newScriptedInstance(
+		scriptedResponse{statusCode: http.StatusTooManyRequests, header: http.Header{"Retry-After": []string{"0"}}},
+		scriptedResponse{statusCode: http.StatusOK, header: http.Header{}},
+	)
+
+	server := httptest.NewServer(instance)
+	t.Cleanup(server.Close)
+
+	var bookmarks model.StatusList
+
+	if err := newTestClient(t, server.URL).GetBookmarks(gtsclient.PaginationArgs{Limit: 20}, &bookmarks); err != nil {
+		t.Fatalf("Unable to get the bookmarks after the rate limited request: %v", err)
+	}
+
+	if got := len(instance.requests()); got != 2 {
+		t.Errorf("Unexpected number of requests: want 2, got %d", got)
+	}
+}
+
+func TestDoNotRetryNonIdempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	instance := newScriptedInstance(
+		scriptedResponse{statusCode: http.StatusServiceUnavailable, header: http.Header{}},
+		scriptedResponse{statusCode: http.StatusOK, header: http.Header{}},
+	)
+
+	server := httptest.NewServer(instance)
+	t.Cleanup(server.Close)
+
+	err := newTestClient(t, server.URL).AddStatusToBookmarks("01JTCA4E0K2M8VQ7T9X3R5N1PB", &gtsclient.NoRPCResults{})
+
+	var responseErr gtsclient.ResponseError
+	if !errors.As(err, &responseErr) || responseErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Unexpected error received: want a response error with status code 503, got %v", err)
+	}
+
+	if got := len(instance.requests()); got != 1 {
+		t.Errorf("Unexpected number of requests: want 1, got %d", got)
+	}
+}
+
+func TestWaitForRateLimitReset(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Second)
+
+	instance := newScriptedInstance(
+		scriptedResponse{
+			statusCode: http.StatusTooManyRequests,
+			header: http.Header{
+				"X-Ratelimit-Limit":     []string{"300"},
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{reset.UTC().Format(time.RFC3339Nano)},
+			},
+		},
+		scriptedResponse{statusCode: http.StatusOK, header: http.Header{}},
+	)
+
+	server := httptest.NewServer(instance)
+	t.Cleanup(server.Close)
+
+	var bookmarks model.StatusList
+
+	if err := newTestClient(t, server.URL).GetBookmarks(gtsclient.PaginationArgs{Limit: 20}, &bookmarks); err != nil {
+		t.Fatalf("Unable to get the bookmarks after the rate limit was reset: %v", err)
+	}
+
+	requests := instance.requests()
+
+	if len(requests) != 2 {
+		t.Fatalf("Unexpected number of requests: want 2, got %d", len(requests))
+	}
+
+	if requests[1].Before(reset) {
+		t.Errorf(
+			"The request was retried before the rate limit was reset: reset at %s, retried at %s",
+			reset,
+			requests[1],
+		)
+	}
+}
+
+func TestWaitForExhaustedRateLimit(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Second)
+
+	instance := newScriptedInstance(
+		scriptedResponse{
+			statusCode: http.StatusOK,
+			header: http.Header{
+				"X-Ratelimit-Limit":     []string{"300"},
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{reset.UTC().Format(time.RFC3339Nano)},
+			},
+		},
+		scriptedResponse{statusCode: http.StatusOK, header: http.Header{}},
+	)
+
+	server := httptest.NewServer(instance)
+	t.Cleanup(server.Close)
+
+	client := newTestClient(t, server.URL)
+
+	for range 2 {
+		var bookmarks model.StatusList
+
+		if err := client.GetBookmarks(gtsclient.PaginationArgs{Limit: 20}, &bookmarks); err != nil {
+			t.Fatalf("Unable to get the bookmarks: %v", err)
+		}
+	}
+
+	requests := instance.requests()
+
+	if len(requests) != 2 {
+		t.Fatalf("Unexpected number of requests: want 2, got %d", len(requests))
+	}
+
+	if requests[1].Before(reset) {
+		t.Errorf(
+			"The request was sent before the rate limit was reset: reset at %s, sent at %s",
+			reset,
+			requests[1],
+		)
+	}
+}
+
+// scriptedResponse is a response sent by the scriptedInstance.
+type scriptedResponse struct {
+	statusCode int
+	header     http.Header
+}
+
+// scriptedInstance is an instance that sends the scripted responses in order.
+// The last response is sent to every request after the script has ended.
+type scriptedInstance struct {
+	mu        sync.Mutex
+	responses []scriptedResponse
+	received  []time.Time
+}
+
+func newScriptedInstance(responses ...scriptedResponse) *scriptedInstance {
+	return &scriptedInstance{
+		mu:        sync.Mutex{},
+		responses: responses,
+		received:  make([]time.Time, 0),
+	}
+}
+
+// requests returns the times that the requests were received.
+func (s *scriptedInstance) requests() []time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append(make([]time.Time, 0, len(s.received)), s.received...)
+}
+
+func (s *scriptedInstance) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.received = append(s.received, time.Now())
+	response := s.responses[min(len(s.received), len(s.responses))-1]
+
+	for key, values := range response.header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	w.WriteHeader(response.statusCode)
+
+	if response.statusCode == http.StatusOK {
+		_, _ = w.Write([]byte("[]"))
+
+		return
+	}
+
+	_, _ = w.Write([]byte(`{"error":"` + strconv.Itoa(response.statusCode) + ` ` + http.StatusText(response.statusCode) + `"}`))
+}
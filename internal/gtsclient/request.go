@@ -1,11 +1,17 @@
 package gtsclient
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/httpcache"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 )
 
 type requestParameters struct {
@@ -14,15 +20,125 @@ type requestParameters struct {
 	contentType string
 	requestBody io.Reader
 	output      any
+	pagination  *model.Pagination
 }
 
+// sendRequest sends the request to the instance. Requests are held back while the
+// instance's rate limit is exhausted and are retried with a backoff when the instance
+// responds with a rate limited or unavailable status.
+// The responses of GET requests are cached when the response cache is enabled.
 func (g *GTSClient) sendRequest(params requestParameters) error {
+	instance := instanceHost(params.url)
+
+	cache, cached, err := g.cachedResponse(params)
+	if err != nil {
+		return err
+	}
+
+	if cached != nil && cached.Fresh(g.cacheTTL(params.url)) {
+		return decodeResponse(params, cached.Body, cached.Link)
+	}
+
+	// The request body is read into memory so that it can be sent again on retries.
+	var body []byte
+
+	if params.requestBody != nil {
+		body, err = io.ReadAll(params.requestBody)
+		if err != nil {
+			return fmt.Errorf("unable to read the request body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := g.waitForRateLimit(instance); err != nil {
+			return err
+		}
+
+		header, err := g.attemptRequest(params, instance, body, cache, cached)
+		if err == nil || attempt >= maxRetries {
+			return err
+		}
+
+		var responseErr ResponseError
+		if !errors.As(err, &responseErr) || !retryable(params.httpMethod, responseErr.StatusCode) {
+			return err
+		}
+
+		delay := retryDelay(responseErr.StatusCode, attempt, header)
+		if delay > maxRateLimitWait {
+			return err
+		}
+
+		time.Sleep(delay)
+	}
+}
+
+// cachedResponse returns the response cache and the cached response for the request.
+// The cached response is nil if the request is not cacheable or the response is not in the cache.
+func (g *GTSClient) cachedResponse(params requestParameters) (*httpcache.Cache, *httpcache.Entry, error) {
+	cache, enabled, err := g.responseCache()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !enabled {
+		return nil, nil, nil
+	}
+
+	if params.httpMethod != http.MethodGet || params.output == nil {
+		return &cache, nil, nil
+	}
+
+	entry, ok, err := cache.Load(params.url)
+	if err != nil || !ok {
+		// A corrupted cache entry is replaced by the next response from the instance.
+		return &cache, nil, nil //nolint:nilerr
+	}
+
+	return &cache, &entry, nil
+}
+
+// waitForRateLimit blocks until the instance's rate limit is reset if there are
+// no requests remaining. An error is returned if the reset is too far away.
+func (g *GTSClient) waitForRateLimit(instance string) error {
+	wait := g.rateLimits.waitTime(instance)
+	if wait <= 0 {
+		return nil
+	}
+
+	if wait > maxRateLimitWait {
+		return RateLimitExceededError{
+			instance: instance,
+			reset:    time.Now().Add(wait),
+		}
+	}
+
+	time.Sleep(wait)
+
+	return nil
+}
+
+// attemptRequest sends the request to the instance once. The headers of the response
+// are returned alongside the error so that the caller can decide when to retry.
+// The cached response is revalidated with the instance if it has validators.
+func (g *GTSClient) attemptRequest(
+	params requestParameters,
+	instance string,
+	body []byte,
+	cache *httpcache.Cache,
+	cached *httpcache.Entry,
+) (http.Header, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
 	defer cancel()
 
-	request, err := http.NewRequestWithContext(ctx, params.httpMethod, params.url, params.requestBody)
+	var requestBody io.Reader
+	if body != nil {
+		requestBody = bytes.NewReader(body)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, params.httpMethod, params.url, requestBody)
 	if err != nil {
-		return fmt.Errorf("unable to create the HTTP request: %w", err)
+		return nil, fmt.Errorf("unable to create the HTTP request: %w", err)
 	}
 
 	if params.contentType != "" {
@@ -37,13 +153,25 @@ func (g *GTSClient) sendRequest(params requestParameters) error {
 		request.Header.Set("Authorization", "Bearer "+token)
 	}
 
+	if cached != nil {
+		if cached.ETag != "" {
+			request.Header.Set("If-None-Match", cached.ETag)
+		}
+
+		if cached.LastModified != "" {
+			request.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
 	response, err := g.httpClient.Do(request)
 	if err != nil {
-		return fmt.Errorf("received an error after sending the request: %w", err)
+		return nil, fmt.Errorf("received an error after sending the request: %w", err)
 	}
 
 	defer response.Body.Close()
 
+	g.rateLimits.update(instance, response.Header)
+
 	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusBadRequest {
 		message := struct {
 			Error string `json:"error"`
@@ -52,25 +180,85 @@ func (g *GTSClient) sendRequest(params requestParameters) error {
 		}
 
 		if err := json.NewDecoder(response.Body).Decode(&message); err != nil {
-			return ResponseError{
+			return response.Header, ResponseError{
 				StatusCode:       response.StatusCode,
 				Message:          "",
 				MessageDecodeErr: err,
 			}
 		}
 
-		return ResponseError{
+		return response.Header, ResponseError{
 			StatusCode:       response.StatusCode,
 			Message:          message.Error,
 			MessageDecodeErr: nil,
 		}
 	}
 
+	if response.StatusCode == http.StatusNotModified && cached != nil {
+		// The cached response is still valid so we only need to record when it was revalidated.
+		// The cache is best effort so a failure to update it does not fail the request.
+		cached.StoredAt = time.Now()
+		_ = cache.Save(*cached)
+
+		return response.Header, decodeResponse(params, cached.Body, cached.Link)
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return response.Header, fmt.Errorf("unable to read the response from the GoToSocial server: %w", err)
+	}
+
+	if cache != nil {
+		g.updateResponseCache(*cache, params, response.Header, data)
+	}
+
+	return response.Header, decodeResponse(params, data, response.Header.Get("Link"))
+}
+
+// updateResponseCache saves the response of a GET request in the cache. Any other request
+// may have changed the state of the account so all of the account's cached responses are removed.
+// The cache is best effort so a failure to update it does not fail the request.
+func (g *GTSClient) updateResponseCache(cache httpcache.Cache, params requestParameters, header http.Header, data []byte) {
+	if params.httpMethod != http.MethodGet {
+		_, _ = cache.Clear()
+
+		return
+	}
+
+	entry := httpcache.Entry{
+		URL:          params.url,
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+		Link:         header.Get("Link"),
+		Body:         data,
+		StoredAt:     time.Now(),
+		Size:         0,
+	}
+
+	if params.output == nil || !json.Valid(data) || (!entry.Revalidatable() && g.cacheTTL(params.url) <= 0) {
+		return
+	}
+
+	_ = cache.Save(entry)
+}
+
+// decodeResponse decodes the body of the response into the request's output
+// and parses the pagination details from the Link header.
+func decodeResponse(params requestParameters, data []byte, linkHeader string) error {
+	if params.pagination != nil {
+		pagination, err := parseLinkHeader(linkHeader)
+		if err != nil {
+			return fmt.Errorf("unable to get the pagination details from the response: %w", err)
+		}
+
+		*params.pagination = pagination
+	}
+
 	if params.output == nil {
 		return nil
 	}
 
-	if err := json.NewDecoder(response.Body).Decode(params.output); err != nil {
+	if err := json.Unmarshal(data, params.output); err != nil {
 		return fmt.Errorf(
 			"unable to decode the response from the GoToSocial server: %w",
 			err,
@@ -0,0 +1,135 @@
+package gtsclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const baseScheduledStatusesPath string = "/api/v1/scheduled_statuses"
+
+// CreateScheduledStatus creates a status that is published by the instance at the
+// time specified in the form.
+func (g *GTSClient) CreateScheduledStatus(form CreateStatusForm, scheduledStatus *model.ScheduledStatus) error {
+	data, err := json.Marshal(form)
+	if err != nil {
+		return fmt.Errorf("unable to create the JSON form: %w", err)
+	}
+
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseStatusesPath,
+		requestBody: bytes.NewBuffer(data),
+		contentType: applicationJSON,
+		output:      scheduledStatus,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to schedule the status: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) GetScheduledStatuses(args PaginationArgs, list *model.ScheduledStatusList) error {
+	scheduledStatuses, pagination, err := getPaginatedList[model.ScheduledStatus](
+		g,
+		baseScheduledStatusesPath,
+		"",
+		args,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the list of scheduled statuses: %w",
+			err,
+		)
+	}
+
+	*list = model.ScheduledStatusList{
+		Name:              "Your scheduled statuses",
+		ScheduledStatuses: scheduledStatuses,
+		Pagination:        pagination,
+	}
+
+	return nil
+}
+
+func (g *GTSClient) GetScheduledStatus(scheduledStatusID string, scheduledStatus *model.ScheduledStatus) error {
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseScheduledStatusesPath + "/" + scheduledStatusID,
+		requestBody: nil,
+		contentType: "",
+		output:      scheduledStatus,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the scheduled status: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+type RescheduleStatusArgs struct {
+	ScheduledStatusID string
+	ScheduledAt       time.Time
+}
+
+func (g *GTSClient) RescheduleStatus(args RescheduleStatusArgs, scheduledStatus *model.ScheduledStatus) error {
+	form := struct {
+		ScheduledAt string `json:"scheduled_at"`
+	}{
+		ScheduledAt: args.ScheduledAt.UTC().Format(time.RFC3339),
+	}
+
+	data, err := json.Marshal(form)
+	if err != nil {
+		return fmt.Errorf("unable to create the JSON form: %w", err)
+	}
+
+	params := requestParameters{
+		httpMethod:  http.MethodPut,
+		url:         g.auth.GetInstanceURL() + baseScheduledStatusesPath + "/" + args.ScheduledStatusID,
+		requestBody: bytes.NewBuffer(data),
+		contentType: applicationJSON,
+		output:      scheduledStatus,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to reschedule the status: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (g *GTSClient) DeleteScheduledStatus(scheduledStatusID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodDelete,
+		url:         g.auth.GetInstanceURL() + baseScheduledStatusesPath + "/" + scheduledStatusID,
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to delete the scheduled status: %w",
+			err,
+		)
+	}
+
+	return nil
+}
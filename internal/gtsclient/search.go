@@ -45,8 +45,9 @@ func (g *GTSClient) SearchTags(args SearchTagsArgs, list *model.TagList) error {
 	}
 
 	*list = model.TagList{
-		Name: "Search results",
-		Tags: results.Tags,
+		Name:       "Search results",
+		Tags:       results.Tags,
+		Pagination: model.Pagination{},
 	}
 
 	return nil
@@ -89,6 +90,7 @@ func (g *GTSClient) SearchAccounts(args SearchAccountsArgs, list *model.AccountL
 		Label:           "Search results",
 		Accounts:        results.Accounts,
 		BlockedAccounts: false,
+		Pagination:      model.Pagination{},
 	}
 
 	return nil
@@ -131,8 +133,9 @@ func (g *GTSClient) SearchStatuses(args SearchStatusesArgs, list *model.StatusLi
 	}
 
 	*list = model.StatusList{
-		Name:     "Search results",
-		Statuses: results.Statuses,
+		Name:       "Search results",
+		Statuses:   results.Statuses,
+		Pagination: model.Pagination{},
 	}
 
 	return nil
@@ -12,6 +12,12 @@ import (
 const baseStatusesPath string = "/api/v1/statuses"
 
 func (g *GTSClient) GetStatus(statusID string, status *model.Status) error {
+	if cached, ok := g.memoryCache.statuses.Get(statusID); ok {
+		*status = cached
+
+		return nil
+	}
+
 	params := requestParameters{
 		httpMethod:  http.MethodGet,
 		url:         g.auth.GetInstanceURL() + baseStatusesPath + "/" + statusID,
@@ -27,23 +33,27 @@ func (g *GTSClient) GetStatus(statusID string, status *model.Status) error {
 		)
 	}
 
+	g.memoryCache.statuses.Add(statusID, *status)
+
 	return nil
 }
 
 type CreateStatusForm struct {
-	Content       string                `json:"status"`
-	InReplyTo     string                `json:"in_reply_to_id"`
-	Language      string                `json:"language"`
-	SpoilerText   string                `json:"spoiler_text"`
-	Boostable     bool                  `json:"boostable"`
-	LocalOnly     bool                  `json:"local_only"`
-	Likeable      bool                  `json:"likeable"`
-	Replyable     bool                  `json:"replyable"`
-	Sensitive     bool                  `json:"sensitive"`
-	Poll          *CreateStatusPollForm `json:"poll,omitempty"`
-	ContentType   string                `json:"content_type"`
-	Visibility    string                `json:"visibility"`
-	AttachmentIDs []string              `json:"media_ids,omitempty"`
+	Content           string                 `json:"status"`
+	InReplyTo         string                 `json:"in_reply_to_id"`
+	Language          string                 `json:"language"`
+	SpoilerText       string                 `json:"spoiler_text"`
+	Boostable         bool                   `json:"boostable"`
+	LocalOnly         bool                   `json:"local_only"`
+	Likeable          bool                   `json:"likeable"`
+	Replyable         bool                   `json:"replyable"`
+	Sensitive         bool                   `json:"sensitive"`
+	Poll              *CreateStatusPollForm  `json:"poll,omitempty"`
+	ContentType       string                 `json:"content_type"`
+	Visibility        string                 `json:"visibility"`
+	AttachmentIDs     []string               `json:"media_ids,omitempty"`
+	ScheduledAt       string                 `json:"scheduled_at,omitempty"`
+	InteractionPolicy *InteractionPolicyForm `json:"interaction_policy,omitempty"`
 }
 
 type CreateStatusPollForm struct {
@@ -76,23 +86,16 @@ func (g *GTSClient) CreateStatus(form CreateStatusForm, status *model.Status) er
 		)
 	}
 
+	if form.InReplyTo != "" {
+		g.memoryCache.invalidateStatus(form.InReplyTo)
+	}
+
 	return nil
 }
 
-func (g *GTSClient) GetBookmarks(limit int, bookmarks *model.StatusList) error {
-	path := fmt.Sprintf("/api/v1/bookmarks?limit=%d", limit)
-
-	var statuses []model.Status
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + path,
-		requestBody: nil,
-		contentType: "",
-		output:      &statuses,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) GetBookmarks(args PaginationArgs, bookmarks *model.StatusList) error {
+	statuses, pagination, err := getPaginatedList[model.Status](g, "/api/v1/bookmarks", "", args)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the bookmarks: %w",
 			err,
@@ -100,8 +103,9 @@ func (g *GTSClient) GetBookmarks(limit int, bookmarks *model.StatusList) error {
 	}
 
 	*bookmarks = model.StatusList{
-		Name:     "Your Bookmarks",
-		Statuses: statuses,
+		Name:       "Your Bookmarks",
+		Statuses:   statuses,
+		Pagination: pagination,
 	}
 
 	return nil
@@ -123,6 +127,8 @@ func (g *GTSClient) AddStatusToBookmarks(statusID string, _ *NoRPCResults) error
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -142,6 +148,8 @@ func (g *GTSClient) RemoveStatusFromBookmarks(statusID string, _ *NoRPCResults)
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -161,6 +169,8 @@ func (g *GTSClient) LikeStatus(statusID string, _ *NoRPCResults) error {
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -180,21 +190,14 @@ func (g *GTSClient) UnlikeStatus(statusID string, _ *NoRPCResults) error {
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
-func (g *GTSClient) GetFavourites(limit int, favourites *model.StatusList) error {
-	var statuses []model.Status
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("/api/v1/favourites?limit=%d", limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &statuses,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) GetFavourites(args PaginationArgs, favourites *model.StatusList) error {
+	statuses, pagination, err := getPaginatedList[model.Status](g, "/api/v1/favourites", "", args)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the list of statuses: %w",
 			err,
@@ -202,8 +205,9 @@ func (g *GTSClient) GetFavourites(limit int, favourites *model.StatusList) error
 	}
 
 	*favourites = model.StatusList{
-		Name:     "Your favourite statuses",
-		Statuses: statuses,
+		Name:       "Your favourite statuses",
+		Statuses:   statuses,
+		Pagination: pagination,
 	}
 
 	return nil
@@ -225,6 +229,8 @@ func (g *GTSClient) ReblogStatus(statusID string, _ *NoRPCResults) error {
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -244,6 +250,8 @@ func (g *GTSClient) UnreblogStatus(statusID string, _ *NoRPCResults) error {
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -263,6 +271,8 @@ func (g *GTSClient) MuteStatus(statusID string, _ *NoRPCResults) error {
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -282,6 +292,50 @@ func (g *GTSClient) UnmuteStatus(statusID string, _ *NoRPCResults) error {
 		)
 	}
 
+	g.memoryCache.invalidateStatus(statusID)
+
+	return nil
+}
+
+func (g *GTSClient) PinStatus(statusID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseStatusesPath + "/" + statusID + "/pin",
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to pin the status: %w",
+			err,
+		)
+	}
+
+	g.memoryCache.invalidateStatus(statusID)
+
+	return nil
+}
+
+func (g *GTSClient) UnpinStatus(statusID string, _ *NoRPCResults) error {
+	params := requestParameters{
+		httpMethod:  http.MethodPost,
+		url:         g.auth.GetInstanceURL() + baseStatusesPath + "/" + statusID + "/unpin",
+		requestBody: nil,
+		contentType: "",
+		output:      nil,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to unpin the status: %w",
+			err,
+		)
+	}
+
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -305,6 +359,8 @@ func (g *GTSClient) DeleteStatus(statusID string, text *string) error {
 
 	*text = status.Text
 
+	g.memoryCache.invalidateStatus(statusID)
+
 	return nil
 }
 
@@ -330,6 +386,7 @@ func (g *GTSClient) GetAccountsWhoLikedStatus(statusID string, list *model.Accou
 		Label:           "LIKED BY",
 		Accounts:        accounts,
 		BlockedAccounts: false,
+		Pagination:      model.Pagination{},
 	}
 
 	return nil
@@ -357,6 +414,7 @@ func (g *GTSClient) GetAccountsWhoRebloggedStatus(statusID string, list *model.A
 		Label:           "BOOSTED BY",
 		Accounts:        accounts,
 		BlockedAccounts: false,
+		Pagination:      model.Pagination{},
 	}
 
 	return nil
@@ -388,14 +446,121 @@ func (g *GTSClient) GetThread(statusID string, thread *model.Thread) error {
 
 	*thread = model.Thread{
 		Ancestors: model.StatusList{
-			Name:     "Ancestors",
-			Statuses: obj.Ancestors,
+			Name:       "Ancestors",
+			Statuses:   obj.Ancestors,
+			Pagination: model.Pagination{},
 		},
 		Descendants: model.StatusList{
-			Name:     "Descendants",
-			Statuses: obj.Descendants,
+			Name:       "Descendants",
+			Statuses:   obj.Descendants,
+			Pagination: model.Pagination{},
 		},
 	}
 
 	return nil
 }
+
+func (g *GTSClient) GetStatusSource(statusID string, source *model.StatusSource) error {
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseStatusesPath + "/" + statusID + "/source",
+		requestBody: nil,
+		contentType: "",
+		output:      source,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the source of the status: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+type EditStatusForm struct {
+	Content         string                     `json:"status"`
+	SpoilerText     string                     `json:"spoiler_text"`
+	Sensitive       bool                       `json:"sensitive"`
+	Language        string                     `json:"language,omitempty"`
+	ContentType     string                     `json:"content_type,omitempty"`
+	AttachmentIDs   []string                   `json:"media_ids"`
+	MediaAttributes []EditStatusMediaAttribute `json:"media_attributes,omitempty"`
+	Poll            *EditStatusPollForm        `json:"poll,omitempty"`
+}
+
+// EditStatusPollForm is the form for the poll of an edited status. The poll
+// is replaced if the options are different from the current ones. An expiry
+// time of zero seconds means that the poll has no expiry time and the
+// instance's current setting for hiding the totals is used if HideTotals
+// is not set.
+type EditStatusPollForm struct {
+	Options    []string `json:"options"`
+	ExpiresIn  int      `json:"expires_in"`
+	Multiple   bool     `json:"multiple"`
+	HideTotals *bool    `json:"hide_totals,omitempty"`
+}
+
+type EditStatusMediaAttribute struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Focus       string `json:"focus,omitempty"`
+}
+
+type EditStatusArgs struct {
+	StatusID string
+	Form     EditStatusForm
+}
+
+func (g *GTSClient) EditStatus(args EditStatusArgs, status *model.Status) error {
+	data, err := json.Marshal(args.Form)
+	if err != nil {
+		return fmt.Errorf("unable to create the JSON form: %w", err)
+	}
+
+	params := requestParameters{
+		httpMethod:  http.MethodPut,
+		url:         g.auth.GetInstanceURL() + baseStatusesPath + "/" + args.StatusID,
+		requestBody: bytes.NewBuffer(data),
+		contentType: applicationJSON,
+		output:      status,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to edit the status: %w",
+			err,
+		)
+	}
+
+	g.memoryCache.invalidateStatus(args.StatusID)
+
+	return nil
+}
+
+func (g *GTSClient) GetStatusHistory(statusID string, history *model.StatusHistory) error {
+	var edits []model.StatusEdit
+
+	params := requestParameters{
+		httpMethod:  http.MethodGet,
+		url:         g.auth.GetInstanceURL() + baseStatusesPath + "/" + statusID + "/history",
+		requestBody: nil,
+		contentType: "",
+		output:      &edits,
+	}
+
+	if err := g.sendRequest(params); err != nil {
+		return fmt.Errorf(
+			"received an error after sending the request to get the edit history of the status: %w",
+			err,
+		)
+	}
+
+	*history = model.StatusHistory{
+		StatusID: statusID,
+		Edits:    edits,
+	}
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package gtsclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+const (
+	StreamUser         string = "user"
+	StreamNotification string = "user:notification"
+	StreamPublic       string = "public"
+	StreamHashtag      string = "hashtag"
+	StreamList         string = "list"
+
+	streamEventsBufferSize = 100
+	streamEventsWaitTime   = 30 * time.Second
+	streamMinBackoff       = 1 * time.Second
+	streamMaxBackoff       = 1 * time.Minute
+
+	// streamIdleTimeout is how long a stream is kept open without the client
+	// retrieving its events. This releases the streams of clients that exit
+	// without closing them.
+	streamIdleTimeout       = 5 * time.Minute
+	streamIdleCheckInterval = 1 * time.Minute
+)
+
+type OpenStreamArgs struct {
+	Stream  string
+	ListID  string
+	TagName string
+}
+
+// stream is a long-lived connection to the streaming API which is
+// owned by the server. The events received from the instance are
+// buffered until they are retrieved by the client.
+type stream struct {
+	events chan model.StreamEvent
+	cancel context.CancelFunc
+
+	// lastPolled is the time (in Unix nanoseconds) when the client
+	// last retrieved the events from the stream.
+	lastPolled atomic.Int64
+}
+
+// idle returns true if the client has not retrieved the events
+// from the stream within the idle timeout.
+func (s *stream) idle() bool {
+	return time.Since(time.Unix(0, s.lastPolled.Load())) > streamIdleTimeout
+}
+
+// streamMessage is the message received from the instance over the WebSocket connection.
+type streamMessage struct {
+	Stream  []string `json:"stream"`
+	Event   string   `json:"event"`
+	Payload string   `json:"payload"`
+}
+
+// OpenStream opens a connection to the streaming API and returns the ID of the
+// stream. The connection is maintained in the background and is re-established
+// with an exponential backoff whenever it drops. The stream remains open until
+// it is closed with CloseStream or the client stops retrieving its events.
+func (g *GTSClient) OpenStream(args OpenStreamArgs, streamID *string) error {
+	streamURL, err := g.streamURL(args)
+	if err != nil {
+		return fmt.Errorf("unable to create the URL for the stream: %w", err)
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Errorf("unable to create the ID for the stream: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	newStream := &stream{
+		events:     make(chan model.StreamEvent, streamEventsBufferSize),
+		cancel:     cancel,
+		lastPolled: atomic.Int64{},
+	}
+
+	newStream.lastPolled.Store(time.Now().UnixNano())
+
+	*streamID = hex.EncodeToString(id)
+
+	g.streamsMu.Lock()
+	g.streams[*streamID] = newStream
+	g.streamsMu.Unlock()
+
+	go g.runStream(ctx, streamURL, newStream.events)
+	go g.closeWhenIdle(ctx, *streamID, newStream)
+
+	return nil
+}
+
+// closeWhenIdle closes the stream when the client has not retrieved
+// its events within the idle timeout.
+func (g *GTSClient) closeWhenIdle(ctx context.Context, streamID string, idleStream *stream) {
+	ticker := time.NewTicker(streamIdleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if idleStream.idle() {
+				_ = g.CloseStream(streamID, nil)
+
+				return
+			}
+		}
+	}
+}
+
+// GetStreamEvents waits for events to arrive on the stream and returns all
+// the events that are available. An empty list is returned if no events
+// arrive within the wait time so that the client can check back later.
+func (g *GTSClient) GetStreamEvents(streamID string, events *[]model.StreamEvent) error {
+	g.streamsMu.Lock()
+	openStream, ok := g.streams[streamID]
+	g.streamsMu.Unlock()
+
+	if !ok {
+		return StreamNotFoundError{streamID: streamID}
+	}
+
+	openStream.lastPolled.Store(time.Now().UnixNano())
+	defer openStream.lastPolled.Store(time.Now().UnixNano())
+
+	*events = make([]model.StreamEvent, 0)
+
+	timer := time.NewTimer(streamEventsWaitTime)
+	defer timer.Stop()
+
+	select {
+	case event, ok := <-openStream.events:
+		if !ok {
+			return StreamClosedError{streamID: streamID}
+		}
+
+		*events = append(*events, event)
+	case <-timer.C:
+		return nil
+	}
+
+	for {
+		select {
+		case event, ok := <-openStream.events:
+			if !ok {
+				return nil
+			}
+
+			*events = append(*events, event)
+		default:
+			return nil
+		}
+	}
+}
+
+// CloseStream closes the connection to the streaming API and removes the stream.
+func (g *GTSClient) CloseStream(streamID string, _ *NoRPCResults) error {
+	g.streamsMu.Lock()
+	defer g.streamsMu.Unlock()
+
+	openStream, ok := g.streams[streamID]
+	if !ok {
+		return StreamNotFoundError{streamID: streamID}
+	}
+
+	openStream.cancel()
+	delete(g.streams, streamID)
+
+	return nil
+}
+
+// runStream connects to the stream and sends the received events to the
+// events channel until the context is cancelled. The connection is
+// re-established with an exponential backoff whenever it drops.
+func (g *GTSClient) runStream(ctx context.Context, streamURL string, events chan<- model.StreamEvent) {
+	defer close(events)
+
+	backoff := streamMinBackoff
+
+	for {
+		conn, err := g.dialStream(ctx, streamURL)
+		if err == nil {
+			backoff = streamMinBackoff
+			err = readStream(ctx, conn, events)
+			_ = conn.Close()
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		sendStreamEvent(events, model.StreamEvent{
+			Event:        model.StreamEventReconnecting,
+			Status:       nil,
+			Notification: nil,
+			DeletedID:    "",
+			Message: fmt.Sprintf(
+				"lost the connection to the stream (%v); reconnecting in %s",
+				err,
+				backoff,
+			),
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff = min(2*backoff, streamMaxBackoff)
+	}
+}
+
+func (g *GTSClient) dialStream(ctx context.Context, streamURL string) (*websocket.Conn, error) {
+	wsConfig, err := websocket.NewConfig(streamURL, g.auth.GetInstanceURL())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the WebSocket configuration: %w", err)
+	}
+
+	wsConfig.Header = http.Header{}
+	wsConfig.Header.Set("User-Agent", g.userAgent)
+
+	// The access token is sent in the Sec-WebSocket-Protocol header
+	// so that it is not included in the URL.
+	token := g.auth.GetToken()
+	if len(token) > 0 {
+		wsConfig.Protocol = []string{token}
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	conn, err := wsConfig.DialContext(dialCtx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the stream: %w", err)
+	}
+
+	return conn, nil
+}
+
+// readStream reads the messages from the WebSocket connection until the
+// connection drops or the context is cancelled. Messages that can't be decoded
+// are skipped so that they don't force a reconnection and the client is
+// notified with a skipped event.
+func readStream(ctx context.Context, conn *websocket.Conn, events chan<- model.StreamEvent) error {
+	// Close the connection when the context is cancelled to unblock the receiver.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	for {
+		var data []byte
+
+		if err := websocket.Message.Receive(conn, &data); err != nil {
+			return fmt.Errorf("error receiving the message from the stream: %w", err)
+		}
+
+		var message streamMessage
+
+		if err := json.Unmarshal(data, &message); err != nil {
+			sendStreamEvent(events, skippedStreamEvent("skipped a message from the stream: unable to decode the message: "+err.Error()))
+
+			continue
+		}
+
+		event, ok, err := parseStreamMessage(message)
+		if err != nil {
+			sendStreamEvent(events, skippedStreamEvent("skipped the "+message.Event+" event from the stream: "+err.Error()))
+
+			continue
+		}
+
+		if ok {
+			sendStreamEvent(events, event)
+		}
+	}
+}
+
+// parseStreamMessage parses the message received from the stream. False is returned if the
+// message is for an event that is not supported.
+func parseStreamMessage(message streamMessage) (model.StreamEvent, bool, error) {
+	event := model.StreamEvent{
+		Event:        message.Event,
+		Status:       nil,
+		Notification: nil,
+		DeletedID:    "",
+		Message:      "",
+	}
+
+	switch message.Event {
+	case model.StreamEventUpdate, model.StreamEventStatusUpdate:
+		var status model.Status
+		if err := json.Unmarshal([]byte(message.Payload), &status); err != nil {
+			return model.StreamEvent{}, false, fmt.Errorf("unable to decode the status from the stream: %w", err)
+		}
+
+		event.Status = &status
+	case model.StreamEventNotification:
+		var notification model.Notification
+		if err := json.Unmarshal([]byte(message.Payload), &notification); err != nil {
+			return model.StreamEvent{}, false, fmt.Errorf("unable to decode the notification from the stream: %w", err)
+		}
+
+		event.Notification = &notification
+	case model.StreamEventDelete:
+		event.DeletedID = message.Payload
+	default:
+		return model.StreamEvent{}, false, nil
+	}
+
+	return event, true, nil
+}
+
+// skippedStreamEvent returns the event that notifies the client
+// that a message from the stream was skipped.
+func skippedStreamEvent(message string) model.StreamEvent {
+	return model.StreamEvent{
+		Event:        model.StreamEventSkipped,
+		Status:       nil,
+		Notification: nil,
+		DeletedID:    "",
+		Message:      message,
+	}
+}
+
+// sendStreamEvent sends the event to the events channel. The event is dropped
+// if the buffer is full so that an abandoned stream does not block forever.
+func sendStreamEvent(events chan<- model.StreamEvent, event model.StreamEvent) {
+	select {
+	case events <- event:
+	default:
+	}
+}
+
+// streamURL returns the WebSocket URL of the stream.
+func (g *GTSClient) streamURL(args OpenStreamArgs) (string, error) {
+	streamURL, err := url.Parse(g.auth.GetInstanceURL())
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the instance URL: %w", err)
+	}
+
+	switch streamURL.Scheme {
+	case "https":
+		streamURL.Scheme = "wss"
+	case "http":
+		streamURL.Scheme = "ws"
+	default:
+		return "", UnsupportedURLSchemeError{scheme: streamURL.Scheme}
+	}
+
+	streamURL.Path = "/api/v1/streaming"
+
+	query := url.Values{}
+	query.Set("stream", args.Stream)
+
+	switch args.Stream {
+	case StreamList:
+		query.Set("list", args.ListID)
+	case StreamHashtag:
+		query.Set("tag", args.TagName)
+	}
+
+	streamURL.RawQuery = query.Encode()
+
+	return streamURL.String(), nil
+}
@@ -0,0 +1,81 @@
+package gtsclient_test
+
+import (
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"golang.org/x/net/websocket"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+)
+
+func TestStreamSkipsUndecodableMessages(t *testing.T) {
+	t.Parallel()
+
+	messages := []string{
+		`not a JSON message`,
+		`{"stream":["user"],"event":"update","payload":"not a status"}`,
+		`{"stream":["user"],"event":"delete","payload":"01JTCA4E0K2M8VQ7T9X3R5N1PB"}`,
+	}
+
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		for _, message := range messages {
+			if err := websocket.Message.Send(conn, message); err != nil {
+				return
+			}
+		}
+
+		// Keep the connection open until the client closes it.
+		var discard string
+		_ = websocket.Message.Receive(conn, &discard)
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(t, server.URL)
+
+	var streamID string
+
+	if err := client.OpenStream(
+		gtsclient.OpenStreamArgs{Stream: gtsclient.StreamUser, ListID: "", TagName: ""},
+		&streamID,
+	); err != nil {
+		t.Fatalf("Unable to open the stream: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.CloseStream(streamID, &gtsclient.NoRPCResults{})
+	})
+
+	var received []model.StreamEvent
+
+	for len(received) < len(messages) {
+		var events []model.StreamEvent
+
+		if err := client.GetStreamEvents(streamID, &events); err != nil {
+			t.Fatalf("Unable to get the events from the stream: %v", err)
+		}
+
+		if len(events) == 0 {
+			t.Fatalf("Timed out waiting for the events: got %d of %d events", len(received), len(messages))
+		}
+
+		received = append(received, events...)
+	}
+
+	got := make([]string, len(received))
+	for idx := range received {
+		got[idx] = received[idx].Event
+	}
+
+	want := []string{model.StreamEventSkipped, model.StreamEventSkipped, model.StreamEventDelete}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Unexpected events received from the stream: want %v, got %v", want, got)
+	}
+
+	if received[2].DeletedID != "01JTCA4E0K2M8VQ7T9X3R5N1PB" {
+		t.Errorf("Unexpected ID of the deleted status: got %q", received[2].DeletedID)
+	}
+}
@@ -12,18 +12,9 @@ const (
 	followedTagsPath = "/api/v1/followed_tags"
 )
 
-func (g *GTSClient) GetFollowedTags(limit int, list *model.TagList) error {
-	var tags []model.Tag
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("%s?limit=%d", followedTagsPath, limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &tags,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) GetFollowedTags(args PaginationArgs, list *model.TagList) error {
+	tags, pagination, err := getPaginatedList[model.Tag](g, followedTagsPath, "", args)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the list of followed tags: %w",
 			err,
@@ -31,8 +22,9 @@ func (g *GTSClient) GetFollowedTags(limit int, list *model.TagList) error {
 	}
 
 	*list = model.TagList{
-		Name: "Followed tags:",
-		Tags: tags,
+		Name:       "Followed tags:",
+		Tags:       tags,
+		Pagination: pagination,
 	}
 
 	return nil
@@ -2,82 +2,69 @@ package gtsclient
 
 import (
 	"fmt"
-	"net/http"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
 )
 
-func (g *GTSClient) GetHomeTimeline(limit int, timeline *model.StatusList) error {
-	path := fmt.Sprintf("/api/v1/timelines/home?limit=%d", limit)
-
+func (g *GTSClient) GetHomeTimeline(args PaginationArgs, timeline *model.StatusList) error {
 	*timeline = model.StatusList{
-		Name:     "Timeline: Home",
-		Statuses: nil,
+		Name:       "Timeline: Home",
+		Statuses:   nil,
+		Pagination: model.Pagination{},
 	}
 
-	return g.getTimeline(path, timeline)
+	return g.getTimeline("/api/v1/timelines/home", args, timeline)
 }
 
-func (g *GTSClient) GetPublicTimeline(limit int, timeline *model.StatusList) error {
-	path := fmt.Sprintf("/api/v1/timelines/public?limit=%d", limit)
-
+func (g *GTSClient) GetPublicTimeline(args PaginationArgs, timeline *model.StatusList) error {
 	*timeline = model.StatusList{
-		Name:     "Timeline: Public",
-		Statuses: nil,
+		Name:       "Timeline: Public",
+		Statuses:   nil,
+		Pagination: model.Pagination{},
 	}
 
-	return g.getTimeline(path, timeline)
+	return g.getTimeline("/api/v1/timelines/public", args, timeline)
 }
 
 type GetListTimelineArgs struct {
-	ListID string
-	Title  string
-	Limit  int
+	ListID     string
+	Title      string
+	Pagination PaginationArgs
 }
 
 func (g *GTSClient) GetListTimeline(args GetListTimelineArgs, timeline *model.StatusList) error {
-	path := fmt.Sprintf("/api/v1/timelines/list/%s?limit=%d", args.ListID, args.Limit)
-
 	*timeline = model.StatusList{
-		Name:     "Timeline: List (" + args.Title + ")",
-		Statuses: nil,
+		Name:       "Timeline: List (" + args.Title + ")",
+		Statuses:   nil,
+		Pagination: model.Pagination{},
 	}
 
-	return g.getTimeline(path, timeline)
+	return g.getTimeline("/api/v1/timelines/list/"+args.ListID, args.Pagination, timeline)
 }
 
 type GetTagTimelineArgs struct {
-	TagName string
-	Limit   int
+	TagName    string
+	Pagination PaginationArgs
 }
 
 func (g *GTSClient) GetTagTimeline(args GetTagTimelineArgs, timeline *model.StatusList) error {
-	path := fmt.Sprintf("/api/v1/timelines/tag/%s?limit=%d", args.TagName, args.Limit)
-
 	*timeline = model.StatusList{
-		Name:     "Timeline: Tag (" + args.TagName + ")",
-		Statuses: nil,
+		Name:       "Timeline: Tag (" + args.TagName + ")",
+		Statuses:   nil,
+		Pagination: model.Pagination{},
 	}
 
-	return g.getTimeline(path, timeline)
+	return g.getTimeline("/api/v1/timelines/tag/"+args.TagName, args.Pagination, timeline)
 }
 
-func (g *GTSClient) getTimeline(path string, timeline *model.StatusList) error {
-	var statuses []model.Status
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + path,
-		requestBody: nil,
-		contentType: "",
-		output:      &statuses,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) getTimeline(path string, args PaginationArgs, timeline *model.StatusList) error {
+	statuses, pagination, err := getPaginatedList[model.Status](g, path, "", args)
+	if err != nil {
 		return fmt.Errorf("received an error after sending the request to get the timeline: %w", err)
 	}
 
 	timeline.Statuses = statuses
+	timeline.Pagination = pagination
 
 	return nil
 }
@@ -9,18 +9,9 @@ import (
 
 const baseTokenPath string = "/api/v1/tokens"
 
-func (g *GTSClient) GetTokens(limit int, tokenList *model.TokenList) error {
-	var tokens []model.Token
-
-	params := requestParameters{
-		httpMethod:  http.MethodGet,
-		url:         g.auth.GetInstanceURL() + fmt.Sprintf("%s?limit=%d", baseTokenPath, limit),
-		requestBody: nil,
-		contentType: "",
-		output:      &tokens,
-	}
-
-	if err := g.sendRequest(params); err != nil {
+func (g *GTSClient) GetTokens(args PaginationArgs, tokenList *model.TokenList) error {
+	tokens, pagination, err := getPaginatedList[model.Token](g, baseTokenPath, "", args)
+	if err != nil {
 		return fmt.Errorf(
 			"received an error after sending the request to get the tokens: %w",
 			err,
@@ -28,8 +19,9 @@ func (g *GTSClient) GetTokens(limit int, tokenList *model.TokenList) error {
 	}
 
 	*tokenList = model.TokenList{
-		Label:  "Your tokens",
-		Tokens: tokens,
+		Label:      "Your tokens",
+		Tokens:     tokens,
+		Pagination: pagination,
 	}
 
 	return nil
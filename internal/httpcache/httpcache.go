@@ -0,0 +1,226 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+const (
+	entryFileExtension string = ".json"
+
+	// The cached responses may include private data from the account
+	// so they are only readable by the user.
+	dirPermissions  os.FileMode = 0o700
+	filePermissions os.FileMode = 0o600
+)
+
+// Entry is a response from the instance that is saved in the cache.
+// The validators and the Link header are saved alongside the body so that the response
+// can be revalidated with the instance and paginated lists can be served from the cache.
+type Entry struct {
+	URL          string          `json:"url"`
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"last_modified,omitempty"`
+	Link         string          `json:"link,omitempty"`
+	Body         json.RawMessage `json:"body"`
+	StoredAt     time.Time       `json:"stored_at"`
+
+	// Size is the size of the cache file and is only set when listing the entries.
+	Size int64 `json:"-"`
+}
+
+// Fresh returns true if the entry can be used without revalidating it with the instance.
+func (e Entry) Fresh(ttl time.Duration) bool {
+	return ttl > 0 && time.Since(e.StoredAt) < ttl
+}
+
+// Revalidatable returns true if the entry has a validator that the instance
+// can use to tell us that the response has not changed.
+func (e Entry) Revalidatable() bool {
+	return e.ETag != "" || e.LastModified != ""
+}
+
+// Cache is a directory of cached responses for a single account.
+type Cache struct {
+	dir string
+}
+
+// New returns the cache for the responses stored in the specified directory.
+func New(dir string) Cache {
+	return Cache{dir: dir}
+}
+
+// Dir returns the directory where the responses are stored.
+func (c Cache) Dir() string {
+	return c.dir
+}
+
+// Load returns the cached response for the URL. False is returned
+// if the response is not in the cache.
+func (c Cache) Load(url string) (Entry, bool, error) {
+	entry, err := loadEntry(c.path(url))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Entry{}, false, nil
+		}
+
+		return Entry{}, false, err
+	}
+
+	return entry, true, nil
+}
+
+// Save saves the response in the cache. The response is written to a temporary file
+// which then replaces the cache file so that a partially written response is never loaded.
+func (c Cache) Save(entry Entry) error {
+	if err := os.MkdirAll(c.dir, dirPermissions); err != nil {
+		return fmt.Errorf("unable to create %s: %w", c.dir, err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("unable to encode the response for %s: %w", entry.URL, err)
+	}
+
+	file, err := os.CreateTemp(c.dir, "."+Key(entry.URL)+"-*")
+	if err != nil {
+		return fmt.Errorf("unable to create the temporary file in %s: %w", c.dir, err)
+	}
+
+	tempPath := file.Name()
+
+	if err := writeFile(file, data); err != nil {
+		_ = os.Remove(tempPath)
+
+		return fmt.Errorf("unable to save the JSON data to %s: %w", tempPath, err)
+	}
+
+	path := c.path(entry.URL)
+
+	if err := os.Rename(tempPath, path); err != nil {
+		_ = os.Remove(tempPath)
+
+		return fmt.Errorf("unable to move the response to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func writeFile(file *os.File, data []byte) error {
+	if err := file.Chmod(filePermissions); err != nil {
+		file.Close()
+
+		return fmt.Errorf("unable to set the file permissions: %w", err)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+
+		return fmt.Errorf("unable to write the data: %w", err)
+	}
+
+	return file.Close()
+}
+
+// Entries returns all the responses in the cache. Corrupted responses are skipped
+// since they are replaced by the next response from the instance.
+func (c Cache) Entries() ([]Entry, error) {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Entry{}, nil
+		}
+
+		return nil, fmt.Errorf("unable to read the contents of %s: %w", c.dir, err)
+	}
+
+	entries := make([]Entry, 0, len(files))
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), entryFileExtension) {
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the file information of %s: %w", file.Name(), err)
+		}
+
+		entry, err := loadEntry(filepath.Join(c.dir, file.Name()))
+		if err != nil {
+			continue
+		}
+
+		entry.Size = info.Size()
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// Clear removes all the responses from the cache and returns the number of responses removed.
+func (c Cache) Clear() (int, error) {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("unable to read the contents of %s: %w", c.dir, err)
+	}
+
+	removed := 0
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), entryFileExtension) {
+			continue
+		}
+
+		path := filepath.Join(c.dir, file.Name())
+
+		if err := os.Remove(path); err != nil {
+			return removed, fmt.Errorf("unable to remove %s: %w", path, err)
+		}
+
+		removed++
+	}
+
+	return removed, nil
+}
+
+func (c Cache) path(url string) string {
+	return filepath.Join(c.dir, Key(url)+entryFileExtension)
+}
+
+// Key returns the hex encoded SHA256 checksum of the value which is used
+// to derive the names of the cache files.
+func Key(value string) string {
+	checksum := sha256.Sum256([]byte(value))
+
+	return hex.EncodeToString(checksum[:])
+}
+
+func loadEntry(path string) (Entry, error) {
+	file, err := utilities.OpenFile(path)
+	if err != nil {
+		return Entry{}, fmt.Errorf("unable to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var entry Entry
+
+	if err := json.NewDecoder(file).Decode(&entry); err != nil {
+		return Entry{}, fmt.Errorf("unable to decode the JSON data from %s: %w", path, err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,171 @@
+package httpcache_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/httpcache"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	cache := httpcache.New(filepath.Join(t.TempDir(), "responses"))
+
+	url := "https://gts.example/api/v1/accounts/01J4VQ6XG5GCWZ3TZFS1KAKZ7E"
+
+	if _, ok, err := cache.Load(url); err != nil || ok {
+		t.Fatalf("Unexpected result from loading a response that is not cached: ok = %t, err = %v", ok, err)
+	}
+
+	entry := httpcache.Entry{
+		URL:          url,
+		ETag:         `"8c9b4c3a"`,
+		LastModified: "",
+		Link:         "",
+		Body:         []byte(`{"id":"01J4VQ6XG5GCWZ3TZFS1KAKZ7E"}`),
+		StoredAt:     time.Date(2026, time.October, 18, 8, 30, 0, 0, time.UTC),
+		Size:         0,
+	}
+
+	if err := cache.Save(entry); err != nil {
+		t.Fatalf("Unable to save the response: %v", err)
+	}
+
+	got, ok, err := cache.Load(url)
+	if err != nil {
+		t.Fatalf("Unable to load the response: %v", err)
+	}
+
+	if !ok {
+		t.Fatal("The saved response was not found in the cache")
+	}
+
+	if got.ETag != entry.ETag || string(got.Body) != string(entry.Body) || !got.StoredAt.Equal(entry.StoredAt) {
+		t.Errorf("Unexpected response loaded from the cache: want %+v, got %+v", entry, got)
+	}
+
+	if !got.Revalidatable() {
+		t.Error("Expected the response with an ETag to be revalidatable")
+	}
+
+	entries, err := cache.Entries()
+	if err != nil {
+		t.Fatalf("Unable to list the cached responses: %v", err)
+	}
+
+	if len(entries) != 1 || entries[0].URL != url || entries[0].Size == 0 {
+		t.Errorf("Unexpected list of cached responses: %+v", entries)
+	}
+
+	removed, err := cache.Clear()
+	if err != nil {
+		t.Fatalf("Unable to clear the cache: %v", err)
+	}
+
+	if removed != 1 {
+		t.Errorf("Unexpected number of responses removed: want 1, got %d", removed)
+	}
+
+	if _, ok, _ := cache.Load(url); ok {
+		t.Error("The response was still found in the cache after it was cleared")
+	}
+}
+
+func TestFresh(t *testing.T) {
+	entry := httpcache.Entry{
+		URL:          "https://gts.example/api/v2/instance",
+		ETag:         "",
+		LastModified: "",
+		Link:         "",
+		Body:         []byte(`{}`),
+		StoredAt:     time.Now().Add(-time.Minute),
+		Size:         0,
+	}
+
+	tests := []struct {
+		ttl  time.Duration
+		want bool
+	}{
+		{ttl: 0, want: false},
+		{ttl: time.Second, want: false},
+		{ttl: time.Hour, want: true},
+	}
+
+	for _, test := range tests {
+		if got := entry.Fresh(test.ttl); got != test.want {
+			t.Errorf("Unexpected freshness with a TTL of %s: want %t, got %t", test.ttl, test.want, got)
+		}
+	}
+}
+
+func TestEntriesSkipsCorruptedResponses(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "responses")
+	cache := httpcache.New(dir)
+
+	entry := httpcache.Entry{
+		URL:          "https://gts.example/api/v1/lists",
+		ETag:         `"4f1d2a"`,
+		LastModified: "",
+		Link:         "",
+		Body:         []byte(`[]`),
+		StoredAt:     time.Now(),
+		Size:         0,
+	}
+
+	if err := cache.Save(entry); err != nil {
+		t.Fatalf("Unable to save the response: %v", err)
+	}
+
+	corrupted := filepath.Join(dir, httpcache.Key("https://gts.example/api/v1/accounts/1")+".json")
+
+	if err := os.WriteFile(corrupted, []byte(`{"url": "https://gts.exa`), 0o600); err != nil {
+		t.Fatalf("Unable to write the corrupted response: %v", err)
+	}
+
+	entries, err := cache.Entries()
+	if err != nil {
+		t.Fatalf("Unable to list the cached responses: %v", err)
+	}
+
+	if len(entries) != 1 || entries[0].URL != entry.URL {
+		t.Errorf("Unexpected cached responses: want only %s, got %+v", entry.URL, entries)
+	}
+}
+
+func TestSavePermissions(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "responses")
+	cache := httpcache.New(dir)
+
+	entry := httpcache.Entry{
+		URL:          "https://gts.example/api/v1/accounts/verify_credentials",
+		ETag:         "",
+		LastModified: "",
+		Link:         "",
+		Body:         []byte(`{}`),
+		StoredAt:     time.Now(),
+		Size:         0,
+	}
+
+	if err := cache.Save(entry); err != nil {
+		t.Fatalf("Unable to save the response: %v", err)
+	}
+
+	dirInfo, err := os.Stat(dir)
+	if err != nil {
+		t.Fatalf("Unable to get the information of the cache directory: %v", err)
+	}
+
+	if got := dirInfo.Mode().Perm(); got != 0o700 {
+		t.Errorf("Unexpected permissions for the cache directory: want 0700, got %#o", got)
+	}
+
+	fileInfo, err := os.Stat(filepath.Join(dir, httpcache.Key(entry.URL)+".json"))
+	if err != nil {
+		t.Fatalf("Unable to get the information of the cached response: %v", err)
+	}
+
+	if got := fileInfo.Mode().Perm(); got != 0o600 {
+		t.Errorf("Unexpected permissions for the cached response: want 0600, got %#o", got)
+	}
+}
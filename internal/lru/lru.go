@@ -0,0 +1,163 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a fixed size, least recently used cache that is safe for concurrent use.
+// Entries older than the cache's maximum age are treated as missing.
+type Cache[K comparable, V any] struct {
+	mu        sync.Mutex
+	capacity  int
+	maxAge    time.Duration
+	items     map[K]*list.Element
+	order     *list.List
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	addedAt time.Time
+}
+
+// Stats is a snapshot of the cache's size and usage.
+type Stats struct {
+	Size      int
+	Capacity  int
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// New returns a cache that holds up to capacity entries for up to maxAge.
+// A maxAge of zero means that entries never expire.
+func New[K comparable, V any](capacity int, maxAge time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		mu:        sync.Mutex{},
+		capacity:  max(capacity, 1),
+		maxAge:    maxAge,
+		items:     make(map[K]*list.Element),
+		order:     list.New(),
+		hits:      0,
+		misses:    0,
+		evictions: 0,
+	}
+}
+
+// Get returns the value stored for the key and marks it as the most recently used.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		c.misses++
+
+		var zero V
+
+		return zero, false
+	}
+
+	item := elem.Value.(*entry[K, V]) //nolint:forcetypeassert
+
+	if c.maxAge > 0 && time.Since(item.addedAt) > c.maxAge {
+		c.removeElement(elem)
+		c.misses++
+
+		var zero V
+
+		return zero, false
+	}
+
+	c.order.MoveToFront(elem)
+	c.hits++
+
+	return item.value, true
+}
+
+// Add stores the value for the key, evicting the least recently used entry if the cache is full.
+func (c *Cache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		item := elem.Value.(*entry[K, V]) //nolint:forcetypeassert
+		item.value = value
+		item.addedAt = time.Now()
+
+		c.order.MoveToFront(elem)
+
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&entry[K, V]{
+		key:     key,
+		value:   value,
+		addedAt: time.Now(),
+	})
+
+	if c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+		c.evictions++
+	}
+}
+
+// Remove removes the entry for the key.
+func (c *Cache[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// RemoveFunc removes all the entries for which remove returns true.
+func (c *Cache[K, V]) RemoveFunc(remove func(key K, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		item := elem.Value.(*entry[K, V]) //nolint:forcetypeassert
+
+		if remove(item.key, item.value) {
+			c.removeElement(elem)
+		}
+
+		elem = next
+	}
+}
+
+// Purge removes all the entries from the cache. The statistics are kept.
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[K]*list.Element)
+	c.order.Init()
+}
+
+// Stats returns a snapshot of the cache's size and usage.
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Stats{
+		Size:      c.order.Len(),
+		Capacity:  c.capacity,
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+	}
+}
+
+func (c *Cache[K, V]) removeElement(elem *list.Element) {
+	item := c.order.Remove(elem).(*entry[K, V]) //nolint:forcetypeassert
+	delete(c.items, item.key)
+}
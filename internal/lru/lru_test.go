@@ -0,0 +1,74 @@
+package lru_test
+
+import (
+	"testing"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/lru"
+)
+
+func TestEviction(t *testing.T) {
+	cache := lru.New[string, int](2, 0)
+
+	cache.Add("one", 1)
+	cache.Add("two", 2)
+
+	// Using "one" makes "two" the least recently used entry.
+	if value, ok := cache.Get("one"); !ok || value != 1 {
+		t.Fatalf("Unexpected result for the key 'one': want 1, got %d (found: %t)", value, ok)
+	}
+
+	cache.Add("three", 3)
+
+	if _, ok := cache.Get("two"); ok {
+		t.Error("The least recently used entry was not evicted")
+	}
+
+	for key, want := range map[string]int{"one": 1, "three": 3} {
+		if got, ok := cache.Get(key); !ok || got != want {
+			t.Errorf("Unexpected result for the key %q: want %d, got %d (found: %t)", key, want, got, ok)
+		}
+	}
+
+	want := lru.Stats{Size: 2, Capacity: 2, Hits: 3, Misses: 1, Evictions: 1}
+	if got := cache.Stats(); got != want {
+		t.Errorf("Unexpected statistics: want %+v, got %+v", want, got)
+	}
+}
+
+func TestRemoval(t *testing.T) {
+	cache := lru.New[string, int](10, 0)
+
+	for key, value := range map[string]int{"one": 1, "two": 2, "three": 3, "four": 4} {
+		cache.Add(key, value)
+	}
+
+	cache.Remove("one")
+	cache.RemoveFunc(func(_ string, value int) bool { return value%2 == 0 })
+
+	if got := cache.Stats().Size; got != 1 {
+		t.Errorf("Unexpected size after removing the entries: want 1, got %d", got)
+	}
+
+	if _, ok := cache.Get("three"); !ok {
+		t.Error("The entry for the key 'three' was unexpectedly removed")
+	}
+
+	cache.Purge()
+
+	if got := cache.Stats().Size; got != 0 {
+		t.Errorf("Unexpected size after purging the cache: want 0, got %d", got)
+	}
+}
+
+func TestMaxAge(t *testing.T) {
+	cache := lru.New[string, int](10, time.Millisecond)
+
+	cache.Add("one", 1)
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := cache.Get("one"); ok {
+		t.Error("The expired entry was returned from the cache")
+	}
+}
@@ -0,0 +1,12 @@
+package mastodoncsv
+
+import "strconv"
+
+type InvalidRecordError struct {
+	line   int
+	reason string
+}
+
+func (e InvalidRecordError) Error() string {
+	return "invalid record on line " + strconv.Itoa(e.line) + ": " + e.reason
+}
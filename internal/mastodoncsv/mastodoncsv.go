@@ -0,0 +1,286 @@
+package mastodoncsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The headers of the CSV files exported by Mastodon. The files for the blocked
+// accounts and the lists do not have a header.
+var (
+	followingHeader = []string{"Account address", "Show boosts", "Notify on new posts", "Languages"}
+	mutedHeader     = []string{"Account address", "Hide notifications"}
+)
+
+// Following is a record from the following_accounts.csv file.
+type Following struct {
+	Line       int
+	Account    string
+	ShowBoosts bool
+	Notify     bool
+	Languages  []string
+}
+
+// Muted is a record from the muted_accounts.csv file.
+type Muted struct {
+	Line              int
+	Account           string
+	HideNotifications bool
+}
+
+// Blocked is a record from the blocked_accounts.csv file.
+type Blocked struct {
+	Line    int
+	Account string
+}
+
+// ListMember is a record from the lists.csv file.
+type ListMember struct {
+	Line     int
+	ListName string
+	Account  string
+}
+
+// ReadFollowing reads the records from a following_accounts.csv file.
+// The boosts are shown for the records that do not specify a value.
+func ReadFollowing(reader io.Reader) ([]Following, error) {
+	rows, err := readRows(reader, followingHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Following, 0, len(rows))
+
+	for _, row := range rows {
+		showBoosts, err := parseBool(row, 1, true)
+		if err != nil {
+			return nil, err
+		}
+
+		notify, err := parseBool(row, 2, false)
+		if err != nil {
+			return nil, err
+		}
+
+		var languages []string
+
+		if value := row.field(3); value != "" {
+			for language := range strings.SplitSeq(value, ",") {
+				languages = append(languages, strings.TrimSpace(language))
+			}
+		}
+
+		records = append(records, Following{
+			Line:       row.line,
+			Account:    row.field(0),
+			ShowBoosts: showBoosts,
+			Notify:     notify,
+			Languages:  languages,
+		})
+	}
+
+	return records, nil
+}
+
+// WriteFollowing writes the records in the format of the following_accounts.csv file.
+func WriteFollowing(writer io.Writer, records []Following) error {
+	rows := make([][]string, 0, len(records)+1)
+	rows = append(rows, followingHeader)
+
+	for _, record := range records {
+		rows = append(rows, []string{
+			record.Account,
+			strconv.FormatBool(record.ShowBoosts),
+			strconv.FormatBool(record.Notify),
+			strings.Join(record.Languages, ","),
+		})
+	}
+
+	return writeRows(writer, rows)
+}
+
+// ReadMuted reads the records from a muted_accounts.csv file.
+// The notifications are hidden for the records that do not specify a value.
+func ReadMuted(reader io.Reader) ([]Muted, error) {
+	rows, err := readRows(reader, mutedHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Muted, 0, len(rows))
+
+	for _, row := range rows {
+		hideNotifications, err := parseBool(row, 1, true)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, Muted{
+			Line:              row.line,
+			Account:           row.field(0),
+			HideNotifications: hideNotifications,
+		})
+	}
+
+	return records, nil
+}
+
+// WriteMuted writes the records in the format of the muted_accounts.csv file.
+func WriteMuted(writer io.Writer, records []Muted) error {
+	rows := make([][]string, 0, len(records)+1)
+	rows = append(rows, mutedHeader)
+
+	for _, record := range records {
+		rows = append(rows, []string{
+			record.Account,
+			strconv.FormatBool(record.HideNotifications),
+		})
+	}
+
+	return writeRows(writer, rows)
+}
+
+// ReadBlocked reads the records from a blocked_accounts.csv file.
+func ReadBlocked(reader io.Reader) ([]Blocked, error) {
+	rows, err := readRows(reader, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Blocked, 0, len(rows))
+
+	for _, row := range rows {
+		records = append(records, Blocked{
+			Line:    row.line,
+			Account: row.field(0),
+		})
+	}
+
+	return records, nil
+}
+
+// WriteBlocked writes the records in the format of the blocked_accounts.csv file.
+func WriteBlocked(writer io.Writer, records []Blocked) error {
+	rows := make([][]string, 0, len(records))
+
+	for _, record := range records {
+		rows = append(rows, []string{record.Account})
+	}
+
+	return writeRows(writer, rows)
+}
+
+// ReadLists reads the records from a lists.csv file.
+func ReadLists(reader io.Reader) ([]ListMember, error) {
+	rows, err := readRows(reader, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]ListMember, 0, len(rows))
+
+	for _, row := range rows {
+		if row.field(1) == "" {
+			return nil, InvalidRecordError{line: row.line, reason: "the account address is missing"}
+		}
+
+		records = append(records, ListMember{
+			Line:     row.line,
+			ListName: row.field(0),
+			Account:  row.field(1),
+		})
+	}
+
+	return records, nil
+}
+
+// WriteLists writes the records in the format of the lists.csv file.
+func WriteLists(writer io.Writer, records []ListMember) error {
+	rows := make([][]string, 0, len(records))
+
+	for _, record := range records {
+		rows = append(rows, []string{record.ListName, record.Account})
+	}
+
+	return writeRows(writer, rows)
+}
+
+type row struct {
+	line   int
+	fields []string
+}
+
+func (r row) field(idx int) string {
+	if idx >= len(r.fields) {
+		return ""
+	}
+
+	return strings.TrimSpace(r.fields[idx])
+}
+
+// readRows reads the rows from the CSV file. The header is skipped if the first row
+// matches the specified header. Empty rows and rows without an account are rejected.
+func readRows(reader io.Reader, header []string) ([]row, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	var rows []row
+
+	for {
+		fields, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CSV data: %w", err)
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		current := row{line: line, fields: fields}
+
+		if len(rows) == 0 && header != nil && strings.EqualFold(current.field(0), header[0]) {
+			continue
+		}
+
+		if current.field(0) == "" {
+			return nil, InvalidRecordError{line: line, reason: "the first field is empty"}
+		}
+
+		rows = append(rows, current)
+	}
+
+	return rows, nil
+}
+
+func writeRows(writer io.Writer, rows [][]string) error {
+	csvWriter := csv.NewWriter(writer)
+
+	if err := csvWriter.WriteAll(rows); err != nil {
+		return fmt.Errorf("unable to write the CSV data: %w", err)
+	}
+
+	return nil
+}
+
+func parseBool(current row, idx int, defaultValue bool) (bool, error) {
+	value := current.field(idx)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, InvalidRecordError{
+			line:   current.line,
+			reason: fmt.Sprintf("%q is not a valid boolean value", value),
+		}
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,170 @@
+package mastodoncsv_test
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/mastodoncsv"
+)
+
+func TestReadFollowing(t *testing.T) {
+	data := `Account address,Show boosts,Notify on new posts,Languages
+alice@social.example,true,false,
+bob@gts.example,false,true,"en,de"
+carol@gts.example
+`
+
+	records, err := mastodoncsv.ReadFollowing(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Unable to read the records: %v", err)
+	}
+
+	want := []mastodoncsv.Following{
+		{Line: 2, Account: "alice@social.example", ShowBoosts: true, Notify: false, Languages: nil},
+		{Line: 3, Account: "bob@gts.example", ShowBoosts: false, Notify: true, Languages: []string{"en", "de"}},
+		{Line: 4, Account: "carol@gts.example", ShowBoosts: true, Notify: false, Languages: nil},
+	}
+
+	if len(records) != len(want) {
+		t.Fatalf("Unexpected number of records: want %d, got %d", len(want), len(records))
+	}
+
+	for idx := range want {
+		if records[idx].Line != want[idx].Line ||
+			records[idx].Account != want[idx].Account ||
+			records[idx].ShowBoosts != want[idx].ShowBoosts ||
+			records[idx].Notify != want[idx].Notify ||
+			!slices.Equal(records[idx].Languages, want[idx].Languages) {
+			t.Errorf("Unexpected record received: want %+v, got %+v", want[idx], records[idx])
+		}
+	}
+}
+
+func TestWriteAndReadMuted(t *testing.T) {
+	records := []mastodoncsv.Muted{
+		{Line: 2, Account: "alice@social.example", HideNotifications: true},
+		{Line: 3, Account: "bob@gts.example", HideNotifications: false},
+	}
+
+	var buffer bytes.Buffer
+
+	if err := mastodoncsv.WriteMuted(&buffer, records); err != nil {
+		t.Fatalf("Unable to write the records: %v", err)
+	}
+
+	got, err := mastodoncsv.ReadMuted(&buffer)
+	if err != nil {
+		t.Fatalf("Unable to read the records: %v", err)
+	}
+
+	if !slices.Equal(got, records) {
+		t.Errorf("Unexpected records received: want %+v, got %+v", records, got)
+	}
+}
+
+func TestWriteAndReadLists(t *testing.T) {
+	records := []mastodoncsv.ListMember{
+		{Line: 1, ListName: "Friends, family", Account: "alice@social.example"},
+		{Line: 2, ListName: "News", Account: "bob@gts.example"},
+	}
+
+	var buffer bytes.Buffer
+
+	if err := mastodoncsv.WriteLists(&buffer, records); err != nil {
+		t.Fatalf("Unable to write the records: %v", err)
+	}
+
+	got, err := mastodoncsv.ReadLists(&buffer)
+	if err != nil {
+		t.Fatalf("Unable to read the records: %v", err)
+	}
+
+	if !slices.Equal(got, records) {
+		t.Errorf("Unexpected records received: want %+v, got %+v", records, got)
+	}
+}
+
+func TestReadInvalidRecords(t *testing.T) {
+	cases := []struct {
+		name string
+		read func() error
+	}{
+		{
+			name: "Invalid boolean value",
+			read: func() error {
+				_, err := mastodoncsv.ReadMuted(strings.NewReader("alice@social.example,maybe\n"))
+
+				return err
+			},
+		},
+		{
+			name: "Missing account in the lists file",
+			read: func() error {
+				_, err := mastodoncsv.ReadLists(strings.NewReader("Friends\n"))
+
+				return err
+			},
+		},
+		{
+			name: "Missing account in the blocked accounts file",
+			read: func() error {
+				_, err := mastodoncsv.ReadBlocked(strings.NewReader("alice@social.example\n,\n"))
+
+				return err
+			},
+		},
+	}
+
+	for _, tc := range slices.All(cases) {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var target mastodoncsv.InvalidRecordError
+			if err := tc.read(); !errors.As(err, &target) {
+				t.Errorf("Unexpected error received: got %v", err)
+			}
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	dir := t.TempDir()
+	progressID := mastodoncsv.ProgressID("followings", []byte("alice@social.example\n"))
+
+	progress, err := mastodoncsv.LoadProgress(dir, progressID)
+	if err != nil {
+		t.Fatalf("Unable to load the progress: %v", err)
+	}
+
+	if progress.Processed != 0 {
+		t.Errorf("Unexpected progress received: want 0, got %d", progress.Processed)
+	}
+
+	if err := mastodoncsv.SaveProgress(dir, progressID, mastodoncsv.Progress{Processed: 5, Failed: []int{2, 4}}); err != nil {
+		t.Fatalf("Unable to save the progress: %v", err)
+	}
+
+	progress, err = mastodoncsv.LoadProgress(dir, progressID)
+	if err != nil {
+		t.Fatalf("Unable to load the progress: %v", err)
+	}
+
+	if progress.Processed != 5 {
+		t.Errorf("Unexpected progress received: want 5, got %d", progress.Processed)
+	}
+
+	if !slices.Equal(progress.Failed, []int{2, 4}) {
+		t.Errorf("Unexpected failed lines received: want [2 4], got %v", progress.Failed)
+	}
+
+	if err := mastodoncsv.DeleteProgress(dir, progressID); err != nil {
+		t.Fatalf("Unable to delete the progress: %v", err)
+	}
+
+	if other := mastodoncsv.ProgressID("followings", []byte("bob@gts.example\n")); other == progressID {
+		t.Error("The progress ID did not change after the contents of the file changed")
+	}
+}
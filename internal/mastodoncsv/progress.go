@@ -0,0 +1,89 @@
+package mastodoncsv
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+// Progress records how many records of a CSV file have been processed
+// so that an interrupted import can be resumed. The line numbers of the
+// records that failed to import are kept so that they are retried when
+// the import is resumed.
+type Progress struct {
+	Processed int       `json:"processed"`
+	Failed    []int     `json:"failed,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ProgressID returns the ID of the progress of importing the CSV data for
+// the specified target. The ID changes whenever the contents of the file
+// change so that the progress of a modified file is never resumed.
+func ProgressID(target string, data []byte) string {
+	checksum := sha256.Sum256(data)
+
+	return target + "-" + hex.EncodeToString(checksum[:8])
+}
+
+// LoadProgress loads the progress with the specified ID from the directory. The
+// zero value is returned if there is no progress to resume.
+func LoadProgress(dir, progressID string) (Progress, error) {
+	path := filepath.Join(dir, progressID+".json")
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Progress{}, nil
+		}
+
+		return Progress{}, fmt.Errorf("unable to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var progress Progress
+
+	if err := json.NewDecoder(file).Decode(&progress); err != nil {
+		return Progress{}, fmt.Errorf("unable to decode the JSON data from %s: %w", path, err)
+	}
+
+	return progress, nil
+}
+
+// SaveProgress saves the progress with the specified ID to the directory.
+func SaveProgress(dir, progressID string, progress Progress) error {
+	if err := utilities.EnsureDirectory(dir); err != nil {
+		return fmt.Errorf("unable to ensure the existence of the progress directory: %w", err)
+	}
+
+	path := filepath.Join(dir, progressID+".json")
+
+	file, err := utilities.CreateFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to create the file at %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(progress); err != nil {
+		return fmt.Errorf("unable to save the JSON data to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// DeleteProgress deletes the progress with the specified ID from the directory.
+func DeleteProgress(dir, progressID string) error {
+	path := filepath.Join(dir, progressID+".json")
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to delete %s: %w", path, err)
+	}
+
+	return nil
+}
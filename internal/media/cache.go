@@ -0,0 +1,205 @@
+package media
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+// bytesPerMebibyte is used to convert the configured size limits from MiB to bytes.
+const bytesPerMebibyte int64 = 1024 * 1024
+
+// cachedFile is a media file in the media cache. The modification time of the file
+// is updated whenever it is viewed so that it can be used as the time of last use.
+type cachedFile struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+// MaxSizeFromMebibytes converts the size limit of the media cache from MiB to bytes.
+func MaxSizeFromMebibytes(size int) int64 {
+	return int64(size) * bytesPerMebibyte
+}
+
+// CacheSummary returns the size, number of files and the oldest entry of the
+// media cache of each instance.
+func CacheSummary(cacheRoot string) (model.MediaCache, error) {
+	dirs, err := utilities.CalculateMediaCacheDirs(cacheRoot)
+	if err != nil {
+		return model.MediaCache{}, fmt.Errorf("unable to calculate the media cache directories: %w", err)
+	}
+
+	summary := model.MediaCache{
+		Instances: make([]model.MediaCacheInstance, 0, len(dirs)),
+	}
+
+	for instance, dir := range dirs {
+		files, err := cachedFiles(dir)
+		if err != nil {
+			return model.MediaCache{}, err
+		}
+
+		entry := model.MediaCacheInstance{
+			Instance:    instance,
+			Directory:   dir,
+			Size:        0,
+			FileCount:   len(files),
+			OldestEntry: time.Time{},
+		}
+
+		for _, file := range files {
+			entry.Size += file.size
+
+			if entry.OldestEntry.IsZero() || file.lastUsed.Before(entry.OldestEntry) {
+				entry.OldestEntry = file.lastUsed
+			}
+		}
+
+		summary.Instances = append(summary.Instances, entry)
+	}
+
+	slices.SortFunc(summary.Instances, func(a, b model.MediaCacheInstance) int {
+		return strings.Compare(a.Instance, b.Instance)
+	})
+
+	return summary, nil
+}
+
+// PruneCache removes the media files that have not been viewed within the olderThan
+// duration and then removes the least recently viewed media files until the total size
+// of the media cache across all instances is no larger than maxSize (in bytes).
+// Either limit is ignored if it is zero. All the media files are removed if both
+// limits are zero.
+func PruneCache(cacheRoot string, olderThan time.Duration, maxSize int64) (model.MediaCacheReport, error) {
+	dirs, err := utilities.CalculateMediaCacheDirs(cacheRoot)
+	if err != nil {
+		return model.MediaCacheReport{}, fmt.Errorf("unable to calculate the media cache directories: %w", err)
+	}
+
+	files := make([]cachedFile, 0)
+
+	for _, dir := range dirs {
+		instanceFiles, err := cachedFiles(dir)
+		if err != nil {
+			return model.MediaCacheReport{}, err
+		}
+
+		files = append(files, instanceFiles...)
+	}
+
+	// Sort the files from the least recently used to the most recently used.
+	slices.SortFunc(files, func(a, b cachedFile) int {
+		return a.lastUsed.Compare(b.lastUsed)
+	})
+
+	var totalSize int64
+
+	for _, file := range files {
+		totalSize += file.size
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	report := model.MediaCacheReport{
+		RemovedFiles: 0,
+		RemovedSize:  0,
+	}
+
+	for _, file := range files {
+		var remove bool
+
+		switch {
+		case olderThan == 0 && maxSize == 0:
+			remove = true
+		case olderThan > 0 && file.lastUsed.Before(cutoff):
+			remove = true
+		case maxSize > 0 && totalSize-report.RemovedSize > maxSize:
+			remove = true
+		}
+
+		if !remove {
+			continue
+		}
+
+		if err := os.Remove(file.path); err != nil {
+			return report, fmt.Errorf("unable to remove %s: %w", file.path, err)
+		}
+
+		report.RemovedFiles++
+		report.RemovedSize += file.size
+	}
+
+	return report, nil
+}
+
+// touch marks the cached media file as recently used.
+func touch(path string) error {
+	now := time.Now()
+
+	if err := os.Chtimes(path, now, now); err != nil {
+		return fmt.Errorf("unable to update the modification time of %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func cachedFiles(dir string) ([]cachedFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the media cache directory %s: %w", dir, err)
+	}
+
+	files := make([]cachedFile, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the information of %s: %w", entry.Name(), err)
+		}
+
+		files = append(files, cachedFile{
+			path:     filepath.Join(dir, entry.Name()),
+			size:     info.Size(),
+			lastUsed: info.ModTime(),
+		})
+	}
+
+	return files, nil
+}
+
+// CacheLimiter limits the total size of the media cache by removing
+// the least recently viewed media files.
+type CacheLimiter struct {
+	cacheRoot string
+	maxSize   int64
+}
+
+// NewCacheLimiter returns a CacheLimiter for the media cache under cacheRoot.
+// The maximum size is specified in MiB.
+func NewCacheLimiter(cacheRoot string, maxSize int) *CacheLimiter {
+	return &CacheLimiter{
+		cacheRoot: cacheRoot,
+		maxSize:   MaxSizeFromMebibytes(maxSize),
+	}
+}
+
+// Enforce removes the least recently viewed media files until the media
+// cache is no larger than the maximum size. Nothing is removed if the
+// maximum size is not set.
+func (l *CacheLimiter) Enforce() (model.MediaCacheReport, error) {
+	if l.maxSize <= 0 {
+		return model.MediaCacheReport{RemovedFiles: 0, RemovedSize: 0}, nil
+	}
+
+	return PruneCache(l.cacheRoot, 0, l.maxSize)
+}
@@ -0,0 +1,183 @@
+package media_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/media"
+)
+
+func TestPruneCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	files := []struct {
+		instance string
+		name     string
+		size     int
+		lastUsed time.Time
+	}{
+		{instance: "gts.example.org", name: "a.png", size: 100, lastUsed: now.Add(-72 * time.Hour)},
+		{instance: "gts.example.org", name: "b.png", size: 200, lastUsed: now.Add(-1 * time.Hour)},
+		{instance: "social.example.net", name: "c.mp4", size: 300, lastUsed: now.Add(-48 * time.Hour)},
+		{instance: "social.example.net", name: "d.mp3", size: 400, lastUsed: now},
+	}
+
+	setup := func(t *testing.T) string {
+		t.Helper()
+
+		root := t.TempDir()
+
+		for _, file := range files {
+			dir := filepath.Join(root, file.instance, "media")
+
+			if err := os.MkdirAll(dir, 0o750); err != nil {
+				t.Fatalf("Unable to create %s: %v", dir, err)
+			}
+
+			path := filepath.Join(dir, file.name)
+
+			if err := os.WriteFile(path, make([]byte, file.size), 0o600); err != nil {
+				t.Fatalf("Unable to create %s: %v", path, err)
+			}
+
+			if err := os.Chtimes(path, file.lastUsed, file.lastUsed); err != nil {
+				t.Fatalf("Unable to set the modification time of %s: %v", path, err)
+			}
+		}
+
+		return root
+	}
+
+	testCases := []struct {
+		name        string
+		olderThan   time.Duration
+		maxSize     int64
+		wantRemoved int
+		wantSize    int64
+		wantKept    int
+	}{
+		{name: "Remove everything", olderThan: 0, maxSize: 0, wantRemoved: 4, wantSize: 1000, wantKept: 0},
+		{name: "Remove files older than a day", olderThan: 24 * time.Hour, maxSize: 0, wantRemoved: 2, wantSize: 400, wantKept: 2},
+		{name: "Remove least recently used files", olderThan: 0, maxSize: 650, wantRemoved: 2, wantSize: 400, wantKept: 2},
+		{name: "Within the size limit", olderThan: 0, maxSize: 1000, wantRemoved: 0, wantSize: 0, wantKept: 4},
+		{name: "Both limits", olderThan: 60 * time.Hour, maxSize: 500, wantRemoved: 3, wantSize: 600, wantKept: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := setup(t)
+
+			report, err := media.PruneCache(root, tc.olderThan, tc.maxSize)
+			if err != nil {
+				t.Fatalf("Unable to prune the media cache: %v", err)
+			}
+
+			if report.RemovedFiles != tc.wantRemoved || report.RemovedSize != tc.wantSize {
+				t.Errorf(
+					"Unexpected report: want %d files (%d bytes) removed, got %d files (%d bytes) removed",
+					tc.wantRemoved,
+					tc.wantSize,
+					report.RemovedFiles,
+					report.RemovedSize,
+				)
+			}
+
+			summary, err := media.CacheSummary(root)
+			if err != nil {
+				t.Fatalf("Unable to get the summary of the media cache: %v", err)
+			}
+
+			kept := 0
+			for _, instance := range summary.Instances {
+				kept += instance.FileCount
+			}
+
+			if kept != tc.wantKept {
+				t.Errorf("Unexpected number of files kept: want %d, got %d", tc.wantKept, kept)
+			}
+		})
+	}
+}
+
+func TestCacheSummary(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "gts.example.org", "media")
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatalf("Unable to create %s: %v", dir, err)
+	}
+
+	oldest := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	for idx, lastUsed := range []time.Time{oldest.Add(time.Hour), oldest} {
+		path := filepath.Join(dir, "file"+string(rune('a'+idx)))
+
+		if err := os.WriteFile(path, make([]byte, 10), 0o600); err != nil {
+			t.Fatalf("Unable to create %s: %v", path, err)
+		}
+
+		if err := os.Chtimes(path, lastUsed, lastUsed); err != nil {
+			t.Fatalf("Unable to set the modification time of %s: %v", path, err)
+		}
+	}
+
+	summary, err := media.CacheSummary(root)
+	if err != nil {
+		t.Fatalf("Unable to get the summary of the media cache: %v", err)
+	}
+
+	if len(summary.Instances) != 1 {
+		t.Fatalf("Unexpected number of instances: want 1, got %d", len(summary.Instances))
+	}
+
+	got := summary.Instances[0]
+
+	if got.Instance != "gts.example.org" || got.FileCount != 2 || got.Size != 20 || !got.OldestEntry.Equal(oldest) {
+		t.Errorf(
+			"Unexpected summary: want gts.example.org with 2 files (20 bytes) and oldest entry %s, got %s with %d files (%d bytes) and oldest entry %s",
+			oldest,
+			got.Instance,
+			got.FileCount,
+			got.Size,
+			got.OldestEntry,
+		)
+	}
+}
+
+func TestCacheLimiterWithoutMaxSize(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "gts.example.org", "media")
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatalf("Unable to create %s: %v", dir, err)
+	}
+
+	path := filepath.Join(dir, "a.png")
+
+	if err := os.WriteFile(path, make([]byte, 100), 0o600); err != nil {
+		t.Fatalf("Unable to create %s: %v", path, err)
+	}
+
+	report, err := media.NewCacheLimiter(root, 0).Enforce()
+	if err != nil {
+		t.Fatalf("Unable to enforce the size limit of the media cache: %v", err)
+	}
+
+	if report.RemovedFiles != 0 {
+		t.Errorf("Unexpected number of files removed: want 0, got %d", report.RemovedFiles)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("The cached file was removed without a maximum size: %v", err)
+	}
+}
@@ -34,7 +34,9 @@ func (m *media) download(client *rpc.Client) error {
 	}
 
 	if fileExists {
-		return nil
+		// Mark the file as recently viewed so that it is the last
+		// to be removed when the media cache is pruned.
+		return touch(m.destination)
 	}
 
 	if err := client.Call(
@@ -209,3 +211,26 @@ func mediaFilepath(cacheDir, mediaURL string) string {
 
 	return filepath.Join(cacheDir, split[len(split)-1])
 }
+
+// DownloadAttachments downloads all the media attachments to the specified directory
+// and returns the paths to the downloaded files. Files that are already present in the
+// directory are not downloaded again.
+func DownloadAttachments(client *rpc.Client, dir string, attachments []model.MediaAttachment) ([]string, error) {
+	filepaths := make([]string, len(attachments))
+
+	for ind := range attachments {
+		obj := media{
+			source:      attachments[ind].URL,
+			destination: mediaFilepath(dir, attachments[ind].URL),
+			mediaType:   attachments[ind].Type,
+		}
+
+		if err := obj.download(client); err != nil {
+			return nil, fmt.Errorf("received an error trying to download the media attachments: %w", err)
+		}
+
+		filepaths[ind] = obj.destination
+	}
+
+	return filepaths, nil
+}
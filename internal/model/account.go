@@ -92,7 +92,8 @@ type AccountRelationship struct {
 }
 
 type AccountList struct {
-	Label           string
-	Accounts        []Account
-	BlockedAccounts bool
+	Label           string     `json:"label"`
+	Accounts        []Account  `json:"accounts"`
+	BlockedAccounts bool       `json:"blocked_accounts"`
+	Pagination      Pagination `json:"pagination"`
 }
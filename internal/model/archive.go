@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// ArchiveIndex is the index of an offline archive of an account's statuses.
+type ArchiveIndex struct {
+	Account    Account        `json:"account"`
+	ExportedAt time.Time      `json:"exported_at"`
+	Statuses   []ArchiveEntry `json:"statuses"`
+}
+
+// ArchiveEntry is the entry of an archived status in the archive's index.
+// The paths are relative to the root of the archive.
+type ArchiveEntry struct {
+	ID         string    `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	URL        string    `json:"url"`
+	Reblog     bool      `json:"reblog"`
+	Reply      bool      `json:"reply"`
+	StatusFile string    `json:"status_file"`
+	MediaFiles []string  `json:"media_files"`
+}
@@ -0,0 +1,34 @@
+package model
+
+// BulkReport is the report of running an action on each item
+// read from standard input.
+type BulkReport struct {
+	Action  string       `json:"action"`
+	Target  string       `json:"target"`
+	Results []BulkResult `json:"results"`
+}
+
+// BulkResult is the result of running the action on a single item.
+type BulkResult struct {
+	Item      string `json:"item"`
+	Succeeded bool   `json:"succeeded"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Succeeded returns the number of items that the action succeeded on.
+func (r BulkReport) Succeeded() int {
+	return len(r.Results) - r.Failed()
+}
+
+// Failed returns the number of items that the action failed on.
+func (r BulkReport) Failed() int {
+	failed := 0
+
+	for idx := range r.Results {
+		if !r.Results[idx].Succeeded {
+			failed++
+		}
+	}
+
+	return failed
+}
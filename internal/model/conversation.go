@@ -0,0 +1,14 @@
+package model
+
+type Conversation struct {
+	Accounts   []Account `json:"accounts"`
+	LastStatus *Status   `json:"last_status"`
+	ID         string    `json:"id"`
+	Unread     bool      `json:"unread"`
+}
+
+type ConversationList struct {
+	Name          string         `json:"name"`
+	Conversations []Conversation `json:"conversations"`
+	Pagination    Pagination     `json:"pagination"`
+}
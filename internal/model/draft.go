@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+)
+
+// Draft is a status that is stored locally so that it can be finished and published later.
+type Draft struct {
+	ID        string      `json:"id"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	Status    DraftStatus `json:"status"`
+}
+
+// DraftStatus is the full set of options used to create the status
+// when the draft is published.
+type DraftStatus struct {
+	AddPoll                   bool             `json:"add_poll"`
+	AttachmentIDs             []string         `json:"attachment_ids"`
+	CanFavouriteAlways        []string         `json:"can_favourite_always"`
+	CanFavouriteWithApproval  []string         `json:"can_favourite_with_approval"`
+	CanReblogAlways           []string         `json:"can_reblog_always"`
+	CanReblogWithApproval     []string         `json:"can_reblog_with_approval"`
+	CanReplyAlways            []string         `json:"can_reply_always"`
+	CanReplyWithApproval      []string         `json:"can_reply_with_approval"`
+	Content                   string           `json:"content"`
+	ContentType               string           `json:"content_type"`
+	InReplyTo                 string           `json:"in_reply_to"`
+	Language                  string           `json:"language"`
+	LocalOnly                 bool             `json:"local_only"`
+	MediaFiles                []DraftMediaFile `json:"media_files"`
+	NotBoostable              bool             `json:"not_boostable"`
+	NotLikeable               bool             `json:"not_likeable"`
+	NotReplyable              bool             `json:"not_replyable"`
+	PollAllowsMultipleChoices bool             `json:"poll_allows_multiple_choices"`
+	PollExpiresIn             int              `json:"poll_expires_in"`
+	PollHidesVoteCounts       bool             `json:"poll_hides_vote_counts"`
+	PollOptions               []string         `json:"poll_options"`
+	ScheduledAt               time.Time        `json:"scheduled_at,omitzero"`
+	Sensitive                 *bool            `json:"sensitive,omitempty"`
+	Summary                   string           `json:"summary"`
+	Visibility                string           `json:"visibility"`
+}
+
+// DraftMediaFile is a media file that is uploaded when the draft is published.
+type DraftMediaFile struct {
+	Path        string `json:"path"`
+	Description string `json:"description"`
+	Focus       string `json:"focus"`
+}
+
+type DraftList struct {
+	Name   string  `json:"name"`
+	Drafts []Draft `json:"drafts"`
+}
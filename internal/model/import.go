@@ -0,0 +1,20 @@
+package model
+
+// ImportReport is the report of importing the records of a CSV file.
+type ImportReport struct {
+	Name      string          `json:"name"`
+	DryRun    bool            `json:"dry_run"`
+	Total     int             `json:"total"`
+	Resumed   int             `json:"resumed"`
+	Retried   int             `json:"retried"`
+	Succeeded int             `json:"succeeded"`
+	Planned   []string        `json:"planned"`
+	Failed    []ImportFailure `json:"failed"`
+}
+
+// ImportFailure is a record from the CSV file that could not be imported.
+type ImportFailure struct {
+	Line    int    `json:"line"`
+	Account string `json:"account"`
+	Reason  string `json:"reason"`
+}
@@ -13,3 +13,9 @@ type InteractionRequest struct {
 	Type       string    `json:"type"`
 	URI        string    `json:"uri"`
 }
+
+type InteractionRequestList struct {
+	Name                string               `json:"name"`
+	InteractionRequests []InteractionRequest `json:"interaction_requests"`
+	Pagination          Pagination           `json:"pagination"`
+}
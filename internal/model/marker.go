@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Markers holds your reading position in the home timeline and in your notifications.
+// The positions are shared with your other clients.
+type Markers struct {
+	Home          Marker `json:"home"`
+	Notifications Marker `json:"notifications"`
+}
+
+// Marker is the ID of the last item that you have read in a timeline.
+type Marker struct {
+	LastReadID string    `json:"last_read_id"`
+	Version    int       `json:"version"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// MediaCache is the summary of the media files downloaded from each instance.
+type MediaCache struct {
+	Instances []MediaCacheInstance `json:"instances"`
+}
+
+// MediaCacheInstance is the summary of the media cache of a single instance.
+// OldestEntry is the time that the least recently viewed media file was last viewed.
+type MediaCacheInstance struct {
+	Instance    string    `json:"instance"`
+	Directory   string    `json:"directory"`
+	Size        int64     `json:"size"`
+	FileCount   int       `json:"file_count"`
+	OldestEntry time.Time `json:"oldest_entry"`
+}
+
+// TotalSize returns the total size of the media cache in bytes.
+func (m MediaCache) TotalSize() int64 {
+	var total int64
+
+	for idx := range m.Instances {
+		total += m.Instances[idx].Size
+	}
+
+	return total
+}
+
+// MediaCacheReport is the result of removing media files from the media cache.
+type MediaCacheReport struct {
+	RemovedFiles int   `json:"removed_files"`
+	RemovedSize  int64 `json:"removed_size"`
+}
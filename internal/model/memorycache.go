@@ -0,0 +1,26 @@
+package model
+
+// MemoryCacheStats is the usage of the in-memory caches of the server process.
+type MemoryCacheStats struct {
+	Caches []CacheStats `json:"caches"`
+}
+
+// CacheStats is the size and usage of a single in-memory cache.
+type CacheStats struct {
+	Name      string `json:"name"`
+	Size      int    `json:"size"`
+	Capacity  int    `json:"capacity"`
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+}
+
+// HitRatio returns the percentage of lookups that were found in the cache.
+func (c CacheStats) HitRatio() float64 {
+	lookups := c.Hits + c.Misses
+	if lookups == 0 {
+		return 0
+	}
+
+	return float64(c.Hits) / float64(lookups) * 100
+}
@@ -9,3 +9,56 @@ type Notification struct {
 	Status    *Status   `json:"status"`
 	Type      string    `json:"type"`
 }
+
+type NotificationList struct {
+	Notifications []Notification `json:"notifications"`
+	Pagination    Pagination     `json:"pagination"`
+}
+
+// NotificationGroup is a set of notifications of the same type about the same status
+// (e.g. the likes of one of your statuses). Notifications that can't be grouped are
+// in a group of their own.
+type NotificationGroup struct {
+	Type            string    `json:"type"`
+	Accounts        []Account `json:"accounts"`
+	Status          *Status   `json:"status"`
+	NotificationIDs []string  `json:"notification_ids"`
+	LatestAt        time.Time `json:"latest_at"`
+}
+
+type NotificationGroupList struct {
+	Groups     []NotificationGroup `json:"groups"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+// NotificationPolicy is the policy for filtering the notifications from accounts
+// that you may not know. Each policy is either accept, filter or drop.
+type NotificationPolicy struct {
+	ForNotFollowing    string                    `json:"for_not_following"`
+	ForNotFollowers    string                    `json:"for_not_followers"`
+	ForNewAccounts     string                    `json:"for_new_accounts"`
+	ForPrivateMentions string                    `json:"for_private_mentions"`
+	ForLimitedAccounts string                    `json:"for_limited_accounts"`
+	Summary            NotificationPolicySummary `json:"summary"`
+}
+
+type NotificationPolicySummary struct {
+	PendingRequestsCount      int `json:"pending_requests_count"`
+	PendingNotificationsCount int `json:"pending_notifications_count"`
+}
+
+// NotificationRequest is the group of notifications from a single account that
+// have been filtered by the notification policy.
+type NotificationRequest struct {
+	Account            Account   `json:"account"`
+	CreatedAt          time.Time `json:"created_at"`
+	ID                 string    `json:"id"`
+	LastStatus         *Status   `json:"last_status"`
+	NotificationsCount string    `json:"notifications_count"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type NotificationRequestList struct {
+	Requests   []NotificationRequest `json:"requests"`
+	Pagination Pagination            `json:"pagination"`
+}
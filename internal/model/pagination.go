@@ -0,0 +1,14 @@
+package model
+
+// Pagination holds the IDs parsed from the Link header of a paginated
+// response from the instance. NextMaxID is used to retrieve the next (older)
+// page of items and PrevMinID is used to retrieve the previous (newer) page.
+type Pagination struct {
+	NextMaxID string `json:"next_max_id,omitempty"`
+	PrevMinID string `json:"prev_min_id,omitempty"`
+}
+
+// HasNextPage returns true if there is another page of items to retrieve.
+func (p Pagination) HasNextPage() bool {
+	return p.NextMaxID != ""
+}
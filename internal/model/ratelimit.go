@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// RateLimit is the state of the rate limit that the instance applies to your requests
+// as reported in the X-RateLimit-* headers of its most recent response.
+type RateLimit struct {
+	Instance  string    `json:"instance"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Reported returns true if the instance reported its rate limit.
+// Instances with rate limiting disabled do not send the rate limit headers.
+func (r RateLimit) Reported() bool {
+	return r.Limit > 0
+}
+
+// Exhausted returns true if there are no requests remaining before the rate limit is reset.
+func (r RateLimit) Exhausted() bool {
+	return r.Reported() && r.Remaining <= 0 && time.Now().Before(r.Reset)
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// ResponseCache is the summary of the responses from the instance
+// that are cached for your account.
+type ResponseCache struct {
+	Directory string           `json:"directory"`
+	Entries   []CachedResponse `json:"entries"`
+}
+
+// CachedResponse is a single response in the cache. ExpiresAt is zero
+// if the response is revalidated with the instance on every request.
+type CachedResponse struct {
+	URL          string    `json:"url"`
+	ResourceType string    `json:"resource_type"`
+	Size         int64     `json:"size"`
+	StoredAt     time.Time `json:"stored_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// TotalSize returns the total size of the cached responses in bytes.
+func (r ResponseCache) TotalSize() int64 {
+	var total int64
+
+	for idx := range r.Entries {
+		total += r.Entries[idx].Size
+	}
+
+	return total
+}
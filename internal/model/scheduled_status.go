@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+)
+
+// ScheduledStatus is a status that will be published at a future date.
+type ScheduledStatus struct {
+	ID               string                `json:"id"`
+	MediaAttachments []MediaAttachment     `json:"media_attachments"`
+	Params           ScheduledStatusParams `json:"params"`
+	ScheduledAt      time.Time             `json:"scheduled_at"`
+}
+
+// ScheduledStatusParams is the set of parameters that will be used
+// to create the status when it is published.
+type ScheduledStatusParams struct {
+	ApplicationID string                    `json:"application_id"`
+	InReplyToID   string                    `json:"in_reply_to_id"`
+	Language      string                    `json:"language"`
+	MediaIDs      []string                  `json:"media_ids"`
+	Poll          ScheduledStatusPollParams `json:"poll"`
+	Sensitive     bool                      `json:"sensitive"`
+	SpoilerText   string                    `json:"spoiler_text"`
+	Text          string                    `json:"text"`
+	Visibility    string                    `json:"visibility"`
+}
+
+type ScheduledStatusPollParams struct {
+	ExpiresIn  int      `json:"expires_in"`
+	HideTotals bool     `json:"hide_totals"`
+	Multiple   bool     `json:"multiple"`
+	Options    []string `json:"options"`
+}
+
+type ScheduledStatusList struct {
+	Name              string            `json:"name"`
+	ScheduledStatuses []ScheduledStatus `json:"scheduled_statuses"`
+	Pagination        Pagination        `json:"pagination"`
+}
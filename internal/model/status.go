@@ -98,6 +98,35 @@ type StatusReblogged struct {
 }
 
 type StatusList struct {
-	Name     string
-	Statuses []Status
+	Name       string     `json:"name"`
+	Statuses   []Status   `json:"statuses"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// StatusSource is the plain text source of a status that is used for editing.
+type StatusSource struct {
+	ID          string `json:"id"`
+	SpoilerText string `json:"spoiler_text"`
+	Text        string `json:"text"`
+}
+
+// StatusEdit is a revision of a status.
+type StatusEdit struct {
+	Account          Account           `json:"account"`
+	Content          string            `json:"content"`
+	CreatedAt        time.Time         `json:"created_at"`
+	Emojis           []Emoji           `json:"emojis"`
+	MediaAttachments []MediaAttachment `json:"media_attachments"`
+	Poll             StatusEditPoll    `json:"poll"`
+	Sensitive        bool              `json:"sensitive"`
+	SpoilerText      string            `json:"spoiler_text"`
+}
+
+type StatusEditPoll struct {
+	Options []PollOption `json:"options"`
+}
+
+type StatusHistory struct {
+	StatusID string       `json:"status_id"`
+	Edits    []StatusEdit `json:"edits"`
 }
@@ -0,0 +1,28 @@
+package model
+
+const (
+	StreamEventUpdate       string = "update"
+	StreamEventStatusUpdate string = "status.update"
+	StreamEventDelete       string = "delete"
+	StreamEventNotification string = "notification"
+
+	// StreamEventReconnecting is not sent by the instance. It is used to
+	// notify the user that the connection to the stream was lost and
+	// that a reconnection attempt will be made shortly.
+	StreamEventReconnecting string = "reconnecting"
+
+	// StreamEventSkipped is not sent by the instance. It is used to notify
+	// the user that a message received from the stream could not be decoded
+	// and was skipped.
+	StreamEventSkipped string = "skipped"
+)
+
+// StreamEvent is an event received from the streaming API. Only the field
+// relevant to the type of event is set.
+type StreamEvent struct {
+	Event        string        `json:"event"`
+	Status       *Status       `json:"status,omitempty"`
+	Notification *Notification `json:"notification,omitempty"`
+	DeletedID    string        `json:"deleted_id,omitempty"`
+	Message      string        `json:"message,omitempty"`
+}
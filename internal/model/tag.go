@@ -8,6 +8,7 @@ type Tag struct {
 }
 
 type TagList struct {
-	Name string
-	Tags []Tag
+	Name       string     `json:"name"`
+	Tags       []Tag      `json:"tags"`
+	Pagination Pagination `json:"pagination"`
 }
@@ -1,7 +1,7 @@
 package model
 
 type Thread struct {
-	Context     Status
-	Ancestors   StatusList
-	Descendants StatusList
+	Context     Status     `json:"context"`
+	Ancestors   StatusList `json:"ancestors"`
+	Descendants StatusList `json:"descendants"`
 }
@@ -11,6 +11,7 @@ type Token struct {
 }
 
 type TokenList struct {
-	Label  string
-	Tokens []Token
+	Label      string     `json:"label"`
+	Tokens     []Token    `json:"tokens"`
+	Pagination Pagination `json:"pagination"`
 }
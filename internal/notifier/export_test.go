@@ -0,0 +1,14 @@
+package notifier
+
+import "context"
+
+// The following unexported functions and methods are exported for the tests in the notifier_test package.
+var Environment = environment
+
+func (n *Notifier) SetStartingPoint() error {
+	return n.setStartingPoint()
+}
+
+func (n *Notifier) Check(ctx context.Context) error {
+	return n.check(ctx)
+}
@@ -0,0 +1,223 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/info"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+)
+
+const (
+	// pageLimit is the number of notifications retrieved per page when checking for new notifications.
+	pageLimit int = 40
+
+	// minInterval is the shortest time allowed between each check for new notifications.
+	minInterval = 10 * time.Second
+
+	// commandTimeout is the time that the notifier command is allowed to run for.
+	commandTimeout = 30 * time.Second
+)
+
+// notificationTypes returns the types of notifications that the notifier command is run for.
+func notificationTypes() []string {
+	return []string{"mention", "follow_request", "poll"}
+}
+
+// Notifier periodically checks for new notifications and runs the user's
+// notifier command for each new mention, follow request and ended poll.
+type Notifier struct {
+	printSettings printer.Settings
+	client        *gtsclient.GTSClient
+	command       []string
+	interval      time.Duration
+	lastSeenID    string
+}
+
+// New creates a new Notifier that runs the specified command.
+func New(
+	printSettings printer.Settings,
+	client *gtsclient.GTSClient,
+	command string,
+	interval int,
+) *Notifier {
+	return &Notifier{
+		printSettings: printSettings,
+		client:        client,
+		command:       strings.Split(command, " "),
+		interval:      max(time.Duration(interval)*time.Second, minInterval),
+		lastSeenID:    "",
+	}
+}
+
+// Run checks for new notifications until the context is cancelled. The notifications
+// received before the notifier started are not reported.
+func (n *Notifier) Run(ctx context.Context) {
+	started := n.start()
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !started {
+				started = n.start()
+
+				continue
+			}
+
+			if err := n.check(ctx); err != nil {
+				printer.PrintFailure(n.printSettings, "Error checking for new notifications: "+err.Error()+".")
+			}
+		}
+	}
+}
+
+// start sets the starting point of the notifier and returns true if the notifier
+// has started. The notifier is started again on the next tick if the starting
+// point could not be set (e.g. the network is not up yet).
+func (n *Notifier) start() bool {
+	if err := n.setStartingPoint(); err != nil {
+		printer.PrintFailure(
+			n.printSettings,
+			"Unable to start the notifier (retrying in "+n.interval.String()+"): "+err.Error()+".",
+		)
+
+		return false
+	}
+
+	printer.PrintInfo("Checking for new notifications every " + n.interval.String() + ".\n")
+
+	return true
+}
+
+// setStartingPoint records the newest notification so that only the
+// notifications received from now on are reported.
+func (n *Notifier) setStartingPoint() error {
+	var list model.NotificationList
+
+	if err := n.client.GetNotificationList(
+		gtsclient.GetNotificationListArgs{
+			Pagination: gtsclient.PaginationArgs{
+				Limit:   1,
+				MaxID:   "",
+				MinID:   "",
+				SinceID: "",
+				All:     false,
+			},
+			IncludeTypes: []string{},
+			ExcludeTypes: []string{},
+		},
+		&list,
+	); err != nil {
+		return fmt.Errorf("unable to get the latest notification: %w", err)
+	}
+
+	if len(list.Notifications) > 0 {
+		n.lastSeenID = list.Notifications[0].ID
+	}
+
+	return nil
+}
+
+// check retrieves the notifications received since the last check and runs
+// the notifier command for each of them, oldest first. The pages are retrieved
+// from the oldest to the newest so that no notification is skipped.
+func (n *Notifier) check(ctx context.Context) error {
+	for {
+		var list model.NotificationList
+
+		if err := n.client.GetNotificationList(
+			gtsclient.GetNotificationListArgs{
+				Pagination: gtsclient.PaginationArgs{
+					Limit:   pageLimit,
+					MaxID:   "",
+					MinID:   n.lastSeenID,
+					SinceID: "",
+					All:     false,
+				},
+				IncludeTypes: notificationTypes(),
+				ExcludeTypes: []string{},
+			},
+			&list,
+		); err != nil {
+			return fmt.Errorf("unable to get the new notifications: %w", err)
+		}
+
+		if len(list.Notifications) == 0 {
+			return nil
+		}
+
+		// The notifications in each page are listed newest first.
+		n.lastSeenID = list.Notifications[0].ID
+
+		for _, notification := range slices.Backward(list.Notifications) {
+			if err := n.notify(ctx, notification); err != nil {
+				printer.PrintFailure(
+					n.printSettings,
+					"Error running the notifier for notification "+notification.ID+": "+err.Error()+".",
+				)
+			}
+		}
+
+		if len(list.Notifications) < pageLimit {
+			return nil
+		}
+	}
+}
+
+// notify runs the notifier command with the details of the notification
+// passed in environment variables.
+func (n *Notifier) notify(ctx context.Context, notification model.Notification) error {
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+
+	command := exec.CommandContext(ctx, n.command[0], n.command[1:]...) // #nosec G204 -- External command call defined in user's configuration file.
+	command.Env = append(os.Environ(), environment(notification)...)
+
+	if err := command.Run(); err != nil {
+		return fmt.Errorf("received an error after running the notifier command: %w", err)
+	}
+
+	return nil
+}
+
+// environment returns the environment variables describing the notification.
+func environment(notification model.Notification) []string {
+	prefix := strings.ToUpper(info.ApplicationName) + "_NOTIFICATION_"
+
+	env := []string{
+		prefix + "ID=" + notification.ID,
+		prefix + "TYPE=" + notification.Type,
+		prefix + "CREATED_AT=" + notification.CreatedAt.Format(time.RFC3339),
+	}
+
+	if notification.Account != nil {
+		env = append(
+			env,
+			prefix+"ACCOUNT="+notification.Account.Acct,
+			prefix+"ACCOUNT_NAME="+notification.Account.DisplayName,
+		)
+	}
+
+	if notification.Status != nil {
+		env = append(
+			env,
+			prefix+"STATUS_ID="+notification.Status.ID,
+			prefix+"STATUS_URL="+notification.Status.URL,
+			prefix+"STATUS_TEXT="+strings.TrimSpace(printer.ConvertHTMLToText(notification.Status.Content)),
+		)
+	}
+
+	return env
+}
@@ -0,0 +1,276 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/notifier"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+)
+
+func TestEnvironment(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, time.May, 4, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		notification model.Notification
+		want         []string
+	}{
+		{
+			name: "Follow request",
+			notification: model.Notification{
+				Account:   &model.Account{Acct: "bob@social.example", DisplayName: "Bob"},
+				CreatedAt: createdAt,
+				ID:        "01JTCA2N4Q3BZ3QSK3E8W2S1VP",
+				Status:    nil,
+				Type:      "follow_request",
+			},
+			want: []string{
+				"ENBAS_NOTIFICATION_ID=01JTCA2N4Q3BZ3QSK3E8W2S1VP",
+				"ENBAS_NOTIFICATION_TYPE=follow_request",
+				"ENBAS_NOTIFICATION_CREATED_AT=2025-05-04T12:30:00Z",
+				"ENBAS_NOTIFICATION_ACCOUNT=bob@social.example",
+				"ENBAS_NOTIFICATION_ACCOUNT_NAME=Bob",
+			},
+		},
+		{
+			name: "Mention",
+			notification: model.Notification{
+				Account:   &model.Account{Acct: "alice", DisplayName: "Alice"},
+				CreatedAt: createdAt,
+				ID:        "01JTCA4F6YQZ8D1W2XBN5C7M3K",
+				Status: &model.Status{
+					ID:      "01JTCA4E0K2M8VQ7T9X3R5N1PB",
+					URL:     "https://gts.example.org/@alice/statuses/01JTCA4E0K2M8VQ7T9X3R5N1PB",
+					Content: "<p>Hello there!</p>",
+				},
+				Type: "mention",
+			},
+			want: []string{
+				"ENBAS_NOTIFICATION_ID=01JTCA4F6YQZ8D1W2XBN5C7M3K",
+				"ENBAS_NOTIFICATION_TYPE=mention",
+				"ENBAS_NOTIFICATION_CREATED_AT=2025-05-04T12:30:00Z",
+				"ENBAS_NOTIFICATION_ACCOUNT=alice",
+				"ENBAS_NOTIFICATION_ACCOUNT_NAME=Alice",
+				"ENBAS_NOTIFICATION_STATUS_ID=01JTCA4E0K2M8VQ7T9X3R5N1PB",
+				"ENBAS_NOTIFICATION_STATUS_URL=https://gts.example.org/@alice/statuses/01JTCA4E0K2M8VQ7T9X3R5N1PB",
+				"ENBAS_NOTIFICATION_STATUS_TEXT=Hello there!",
+			},
+		},
+		{
+			name: "Poll without an account",
+			notification: model.Notification{
+				Account:   nil,
+				CreatedAt: createdAt,
+				ID:        "01JTCA6T3N8B2Z5QW1C4X7R9KD",
+				Status:    nil,
+				Type:      "poll",
+			},
+			want: []string{
+				"ENBAS_NOTIFICATION_ID=01JTCA6T3N8B2Z5QW1C4X7R9KD",
+				"ENBAS_NOTIFICATION_TYPE=poll",
+				"ENBAS_NOTIFICATION_CREATED_AT=2025-05-04T12:30:00Z",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := notifier.Environment(tc.notification); !slices.Equal(got, tc.want) {
+				t.Errorf("Unexpected environment variables:\nwant: %v\ngot:  %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	instance := newTestInstance()
+
+	server := httptest.NewServer(instance)
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	output := filepath.Join(dir, "notified.txt")
+	script := filepath.Join(dir, "notify.sh")
+
+	if err := os.WriteFile(
+		script,
+		[]byte("#!/bin/sh\necho \"$ENBAS_NOTIFICATION_ID\" >> "+output+"\n"),
+		0o700,
+	); err != nil {
+		t.Fatalf("Unable to create the notifier script: %v", err)
+	}
+
+	// The notifications received before the notifier started are not reported.
+	instance.add(3)
+
+	n := notifier.New(
+		printer.NewSettings(true, "", 0, printer.OutputFormatText),
+		newTestClient(t, server.URL),
+		script,
+		60,
+	)
+
+	if err := n.SetStartingPoint(); err != nil {
+		t.Fatalf("Unable to set the starting point: %v", err)
+	}
+
+	// The new notifications span more than one page.
+	instance.add(45)
+
+	if err := n.Check(context.Background()); err != nil {
+		t.Fatalf("Unable to check for new notifications: %v", err)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatalf("Unable to read the IDs of the notified notifications: %v", err)
+	}
+
+	want := make([]string, 0, 45)
+	for id := 4; id <= 48; id++ {
+		want = append(want, notificationID(id))
+	}
+
+	if got := strings.Fields(string(data)); !slices.Equal(got, want) {
+		t.Errorf("Unexpected notifications reported:\nwant: %v\ngot:  %v", want, got)
+	}
+
+	wantMinIDs := []string{notificationID(3), notificationID(43)}
+	if got := instance.minIDs(); !slices.Equal(got, wantMinIDs) {
+		t.Errorf("Unexpected min_id values requested: want %v, got %v", wantMinIDs, got)
+	}
+}
+
+// testInstance is a GoToSocial instance that lists its notifications
+// in the same way as the notifications endpoint.
+type testInstance struct {
+	mu            sync.Mutex
+	notifications []model.Notification
+	requested     []string
+}
+
+func newTestInstance() *testInstance {
+	return &testInstance{
+		mu:            sync.Mutex{},
+		notifications: make([]model.Notification, 0),
+		requested:     make([]string, 0),
+	}
+}
+
+// add adds the specified number of new mentions to the instance.
+func (i *testInstance) add(count int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for range count {
+		i.notifications = append(i.notifications, model.Notification{
+			Account:   &model.Account{Acct: "alice", DisplayName: "Alice"},
+			CreatedAt: time.Now().UTC(),
+			ID:        notificationID(len(i.notifications) + 1),
+			Status:    nil,
+			Type:      "mention",
+		})
+	}
+}
+
+func (i *testInstance) minIDs() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return slices.Clone(i.requested)
+}
+
+// ServeHTTP lists the notifications newest first. With min_id the page of
+// notifications immediately after min_id is returned and without it the
+// newest notifications are returned.
+func (i *testInstance) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	query := r.URL.Query()
+
+	var limit int
+	if _, err := fmt.Sscan(query.Get("limit"), &limit); err != nil {
+		http.Error(w, `{"error":"invalid limit"}`, http.StatusBadRequest)
+
+		return
+	}
+
+	var page []model.Notification
+
+	if minID := query.Get("min_id"); minID != "" {
+		i.requested = append(i.requested, minID)
+
+		start := slices.IndexFunc(i.notifications, func(notification model.Notification) bool {
+			return notification.ID > minID
+		})
+
+		if start != -1 {
+			page = slices.Clone(i.notifications[start:min(start+limit, len(i.notifications))])
+		}
+	} else {
+		page = slices.Clone(i.notifications[max(len(i.notifications)-limit, 0):])
+	}
+
+	slices.Reverse(page)
+
+	if page == nil {
+		page = make([]model.Notification, 0)
+	}
+
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+func notificationID(id int) string {
+	return fmt.Sprintf("%02d", id)
+}
+
+// newTestClient returns a client for the test instance at instanceURL.
+func newTestClient(t *testing.T, instanceURL string) *gtsclient.GTSClient {
+	t.Helper()
+
+	var cfg config.Config
+
+	cfg.CredentialsFile = filepath.Join(t.TempDir(), "credentials.json")
+	cfg.CacheDirectory = t.TempDir()
+	cfg.GTSClient.Timeout = 5
+	cfg.GTSClient.MediaTimeout = 5
+
+	client, err := gtsclient.NewGTSClient(cfg)
+	if err != nil {
+		t.Fatalf("Unable to create the client: %v", err)
+	}
+
+	credentials := config.Credentials{
+		Instance:     instanceURL,
+		ClientID:     "",
+		ClientSecret: "",
+		AccessToken:  "token",
+	}
+
+	if err := client.UpdateAuthentication(credentials, &gtsclient.NoRPCResults{}); err != nil {
+		t.Fatalf("Unable to update the authentication details: %v", err)
+	}
+
+	return client
+}
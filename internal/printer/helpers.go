@@ -2,6 +2,7 @@ package printer
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -11,23 +12,26 @@ import (
 
 func funcMap(settings Settings, myAccountID string) template.FuncMap {
 	return template.FuncMap{
-		"convertHTMLToText":     convertHTMLToText,
-		"formatDate":            formatDate,
-		"formatDateTime":        formatDateTime,
-		"headerFormat":          headerFormat(settings.noColor),
-		"fieldFormat":           fieldFormat(settings.noColor),
-		"fullDisplayNameFormat": fullDisplayNameFormat(settings.noColor),
-		"boldFormat":            boldFormat(settings.noColor),
-		"drawCardSeparator":     drawCardSeparator(settings.lineWrapCharacterLimit),
-		"drawBoostSymbol":       drawBoostSymbol(settings.noColor),
-		"drawLikeSymbol":        drawLikeSymbol(settings.noColor),
-		"drawBookmarkSymbol":    drawBookmarkSymbol(settings.noColor),
-		"wrapLines":             wrapLines(settings.lineWrapCharacterLimit),
-		"showPollResults":       showPollResults(myAccountID),
-		"getPollOptionDetails":  getPollOptionDetails(settings.noColor, settings.lineWrapCharacterLimit),
-		"notificationSummary":   notificationSummary,
-		"statusFilterAction":    statusFilterAction,
-		"statusFilteredTitle":   statusFilteredTitle(settings.noColor),
+		"convertHTMLToText":        convertHTMLToText,
+		"formatDate":               formatDate,
+		"formatDateTime":           formatDateTime,
+		"formatSize":               FormatSize,
+		"headerFormat":             headerFormat(settings.noColor),
+		"fieldFormat":              fieldFormat(settings.noColor),
+		"fullDisplayNameFormat":    fullDisplayNameFormat(settings.noColor),
+		"boldFormat":               boldFormat(settings.noColor),
+		"drawCardSeparator":        drawCardSeparator(settings.lineWrapCharacterLimit),
+		"drawBoostSymbol":          drawBoostSymbol(settings.noColor),
+		"drawLikeSymbol":           drawLikeSymbol(settings.noColor),
+		"drawBookmarkSymbol":       drawBookmarkSymbol(settings.noColor),
+		"wrapLines":                wrapLines(settings.lineWrapCharacterLimit),
+		"showPollResults":          showPollResults(myAccountID),
+		"getPollOptionDetails":     getPollOptionDetails(settings.noColor, settings.lineWrapCharacterLimit),
+		"notificationSummary":      notificationSummary,
+		"notificationGroupSummary": notificationGroupSummary(settings.noColor),
+		"interactionSummary":       interactionSummary,
+		"statusFilterAction":       statusFilterAction,
+		"statusFilteredTitle":      statusFilteredTitle(settings.noColor),
 	}
 }
 
@@ -39,6 +43,24 @@ func formatDateTime(date time.Time) string {
 	return date.Local().Format("02 Jan 2006, 15:04 (MST)") //nolint:gosmopolitan
 }
 
+// FormatSize formats the size in bytes using binary units (e.g. "1.5 MiB").
+func FormatSize(size int64) string {
+	const unit = 1024
+
+	if size < unit {
+		return strconv.FormatInt(size, 10) + " B"
+	}
+
+	div, exp := int64(unit), 0
+
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return strconv.FormatFloat(float64(size)/float64(div), 'f', 1, 64) + " " + string("KMGTPE"[exp]) + "iB"
+}
+
 func showPollResults(myAccountID string) func(string, bool, bool) bool {
 	return func(statusOwnerID string, expired, voted bool) bool {
 		return (myAccountID == statusOwnerID) || expired || voted
@@ -196,6 +218,45 @@ func notificationSummary(notificationType string, fullDisplayName string) notifi
 	}
 }
 
+// notificationGroupSummary returns the summary of a group of notifications
+// (e.g. "5 people liked your status.").
+func notificationGroupSummary(noColor bool) func(model.NotificationGroup) string {
+	return func(group model.NotificationGroup) string {
+		if len(group.Accounts) <= 1 {
+			fullDisplayName := ""
+			if len(group.Accounts) == 1 {
+				fullDisplayName = fullDisplayNameFormat(noColor)(group.Accounts[0].DisplayName, group.Accounts[0].Acct)
+			}
+
+			return notificationSummary(group.Type, fullDisplayName).Details
+		}
+
+		count := strconv.Itoa(len(group.Accounts))
+
+		switch group.Type {
+		case "favourite":
+			return count + " people liked your status."
+		case "reblog":
+			return count + " people boosted your status."
+		default:
+			return notificationSummary(group.Type, count+" people").Details
+		}
+	}
+}
+
+func interactionSummary(interactionType string, fullDisplayName string) string {
+	switch interactionType {
+	case "favourite":
+		return fullDisplayName + " wants to like your status."
+	case "reblog":
+		return fullDisplayName + " wants to boost your status."
+	case "reply":
+		return fullDisplayName + " wants to reply to your status."
+	default:
+		return fullDisplayName + " wants to interact with your status."
+	}
+}
+
 func statusFilterAction(filterResults []model.FilterResult) string {
 	if len(filterResults) == 0 {
 		return ""
@@ -19,6 +19,11 @@ type htmlConvertState struct {
 	orderedListIndex int
 }
 
+// ConvertHTMLToText converts the HTML content of a status to plain text.
+func ConvertHTMLToText(text string) string {
+	return convertHTMLToText(text)
+}
+
 func convertHTMLToText(text string) string {
 	var builder strings.Builder
 
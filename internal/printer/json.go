@@ -0,0 +1,56 @@
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// The output formats supported by the printer.
+const (
+	OutputFormatJSON  string = "json"
+	OutputFormatJSONL string = "jsonl"
+	OutputFormatText  string = "text"
+)
+
+// renderJSON encodes the data as JSON and writes it to standard output.
+// The data is indented when the output format is set to json and is
+// written on a single line when the output format is set to jsonl.
+func renderJSON(settings Settings, data any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
+
+	if settings.outputFormat == OutputFormatJSON {
+		encoder.SetIndent("", "  ")
+	}
+
+	if err := encoder.Encode(data); err != nil {
+		return fmt.Errorf("error encoding the data to JSON: %w", err)
+	}
+
+	return nil
+}
+
+// renderListToPager renders the list with the specified template when the
+// output format is set to text, or encodes the whole list as a single
+// JSON document when the output format is set to json. When the output format
+// is set to jsonl each item in the list is written on its own line.
+func renderListToPager[T any](
+	settings Settings,
+	templateName string,
+	myAccountID string,
+	list any,
+	items []T,
+) error {
+	if settings.outputFormat != OutputFormatJSONL {
+		return renderTemplateToPager(settings, templateName, myAccountID, list)
+	}
+
+	for idx := range items {
+		if err := renderJSON(settings, items[idx]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
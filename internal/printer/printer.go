@@ -21,33 +21,81 @@ type Settings struct {
 	noColor                bool
 	lineWrapCharacterLimit int
 	pager                  string
+	outputFormat           string
+	quiet                  bool
 }
 
 func NewSettings(
 	noColor bool,
 	pager string,
 	lineWrapCharacterLimit int,
+	outputFormat string,
 ) Settings {
 	if lineWrapCharacterLimit < minTerminalWidth {
 		lineWrapCharacterLimit = minTerminalWidth
 	}
 
+	if outputFormat != OutputFormatJSON && outputFormat != OutputFormatJSONL {
+		outputFormat = OutputFormatText
+	}
+
 	return Settings{
 		noColor:                noColor,
 		lineWrapCharacterLimit: lineWrapCharacterLimit,
 		pager:                  pager,
+		outputFormat:           outputFormat,
+		quiet:                  false,
 	}
 }
 
+// TextOutput returns true if the results are printed as human-readable text.
+func (s Settings) TextOutput() bool {
+	return s.outputFormat == OutputFormatText
+}
+
+// WithTextOutput returns a copy of the settings with the output format set to text
+// and the line wrap limit set to the specified number of characters.
+func (s Settings) WithTextOutput(lineWrapCharacterLimit int) Settings {
+	return NewSettings(s.noColor, "", lineWrapCharacterLimit, OutputFormatText)
+}
+
+// Quiet returns a copy of the settings where the success messages are not printed.
+// This is used when the results of an operation are summarised elsewhere.
+func (s Settings) Quiet() Settings {
+	s.quiet = true
+
+	return s
+}
+
+// withTextOutput returns a copy of the settings with the output format
+// set to text.
+func (s Settings) withTextOutput() Settings {
+	s.outputFormat = OutputFormatText
+
+	return s
+}
+
 // PrintSuccess prints the successful message to standard output.
+// The message is printed to standard error instead when the output format
+// is not set to text so that standard output only contains the JSON data.
 func PrintSuccess(settings Settings, text string) {
 	const icon = "\u2714"
 
+	if settings.quiet {
+		return
+	}
+
 	success := boldgreen + icon + " " + reset
 	if settings.noColor {
 		success = icon + " "
 	}
 
+	if !settings.TextOutput() {
+		printToStderr(success + " " + text + "\n")
+
+		return
+	}
+
 	printToStdout(success + " " + text + "\n")
 }
 
@@ -68,20 +116,26 @@ func PrintInfo(text string) {
 	printToStdout(text)
 }
 
+// PrintPrompt prints the message to standard error so that prompts and
+// their follow-up messages never mix with the data printed to standard output.
+func PrintPrompt(text string) {
+	printToStderr(text)
+}
+
 // PrintVersion prints the binary build information.
 func PrintVersion(settings Settings, showFullVersion bool) error {
-	if !showFullVersion {
+	if !showFullVersion && settings.TextOutput() {
 		printToStdout(info.ApplicationTitledName + " " + info.BinaryVersion + "\n")
 
 		return nil
 	}
 
 	data := struct {
-		Name          string
-		BinaryVersion string
-		GitCommit     string
-		GoVersion     string
-		BuildTime     string
+		Name          string `json:"name"`
+		BinaryVersion string `json:"binary_version"`
+		GitCommit     string `json:"git_commit"`
+		GoVersion     string `json:"go_version"`
+		BuildTime     string `json:"build_time"`
 	}{
 		Name:          info.ApplicationTitledName,
 		BinaryVersion: info.BinaryVersion,
@@ -108,10 +162,10 @@ func PrintAccount(
 	myAccountID string,
 ) error {
 	data := struct {
-		Account      model.Account
-		Relationship model.AccountRelationship
-		Preferences  model.Preferences
-		StatusList   model.StatusList
+		Account      model.Account             `json:"account"`
+		Relationship model.AccountRelationship `json:"relationship,omitzero"`
+		Preferences  model.Preferences         `json:"preferences,omitzero"`
+		StatusList   model.StatusList          `json:"statuses,omitzero"`
 	}{
 		Account:      account,
 		Relationship: relationship,
@@ -125,10 +179,10 @@ func PrintAccount(
 // PrintAccountList prints the list of accounts to the pager.
 func PrintAccountList(settings Settings, list model.AccountList) error {
 	if list.BlockedAccounts {
-		return renderTemplateToPager(settings, "blockedAccounts", "", list)
+		return renderListToPager(settings, "blockedAccounts", "", list, list.Accounts)
 	}
 
-	return renderTemplateToPager(settings, "accountList", "", list)
+	return renderListToPager(settings, "accountList", "", list, list.Accounts)
 }
 
 // PrintStatus prints the status to the pager.
@@ -140,9 +194,9 @@ func PrintStatus(
 	likedBy model.AccountList,
 ) error {
 	data := struct {
-		Status    model.Status
-		BoostedBy model.AccountList
-		LikedBy   model.AccountList
+		Status    model.Status      `json:"status"`
+		BoostedBy model.AccountList `json:"boosted_by,omitzero"`
+		LikedBy   model.AccountList `json:"liked_by,omitzero"`
 	}{
 		Status:    status,
 		BoostedBy: boostedBy,
@@ -152,9 +206,64 @@ func PrintStatus(
 	return renderTemplateToPager(settings, "statusDoc", myAccountID, data)
 }
 
+// PrintStatusHistory prints the edit history of the status to the pager.
+func PrintStatusHistory(settings Settings, history model.StatusHistory) error {
+	return renderListToPager(settings, "statusHistory", "", history, history.Edits)
+}
+
 // PrintStatusList prints a list of status cards to the pager.
 func PrintStatusList(settings Settings, list model.StatusList, myAccountID string) error {
-	return renderTemplateToPager(settings, "statusList", myAccountID, list)
+	return renderListToPager(settings, "statusList", myAccountID, list, list.Statuses)
+}
+
+// PrintScheduledStatus prints the details of the scheduled status to the pager.
+func PrintScheduledStatus(settings Settings, scheduledStatus model.ScheduledStatus) error {
+	return renderTemplateToPager(settings, "scheduledStatusDoc", "", scheduledStatus)
+}
+
+// PrintScheduledStatusList prints a list of scheduled status cards to the pager.
+func PrintScheduledStatusList(settings Settings, list model.ScheduledStatusList) error {
+	return renderListToPager(settings, "scheduledStatusList", "", list, list.ScheduledStatuses)
+}
+
+// PrintDraft prints the details of the draft to the pager.
+func PrintDraft(settings Settings, draft model.Draft) error {
+	return renderTemplateToPager(settings, "draftDoc", "", draft)
+}
+
+// PrintDraftList prints a list of draft cards to the pager.
+func PrintDraftList(settings Settings, list model.DraftList) error {
+	return renderListToPager(settings, "draftList", "", list, list.Drafts)
+}
+
+// PrintDefaultInteractionPolicies prints your default interaction policies to the pager.
+func PrintDefaultInteractionPolicies(settings Settings, policies model.DefaultInteractionPolicies) error {
+	return renderTemplateToPager(settings, "defaultInteractionPolicies", "", policies)
+}
+
+// PrintInteractionRequest prints the details of the interaction request to the pager.
+func PrintInteractionRequest(settings Settings, request model.InteractionRequest) error {
+	return renderTemplateToPager(settings, "interactionRequestDoc", "", request)
+}
+
+// PrintInteractionRequestList prints the list of interaction requests to the pager.
+func PrintInteractionRequestList(settings Settings, list model.InteractionRequestList) error {
+	return renderListToPager(settings, "interactionRequestList", "", list, list.InteractionRequests)
+}
+
+// PrintConversationList prints the list of conversations to the pager.
+func PrintConversationList(settings Settings, list model.ConversationList, myAccountID string) error {
+	return renderListToPager(settings, "conversationList", myAccountID, list, list.Conversations)
+}
+
+// PrintImportReport prints the report of importing the records of a CSV file.
+func PrintImportReport(settings Settings, report model.ImportReport) error {
+	return renderTemplateToPager(settings, "importReport", "", report)
+}
+
+// PrintBulkReport prints the results of running an action on each item read from standard input.
+func PrintBulkReport(settings Settings, report model.BulkReport) error {
+	return renderTemplateToPager(settings, "bulkReport", "", report)
 }
 
 // PrintInstance prints the instance information to the pager.
@@ -167,6 +276,26 @@ func PrintMediaAttachment(settings Settings, attachement model.MediaAttachment)
 	return renderTemplateToPager(settings, "mediaAttachmentDoc", "", attachement)
 }
 
+// PrintMemoryCacheStats prints the usage statistics of the server's in-memory caches to the pager.
+func PrintMemoryCacheStats(settings Settings, stats model.MemoryCacheStats) error {
+	return renderTemplateToPager(settings, "memoryCacheStats", "", stats)
+}
+
+// PrintRateLimit prints the rate limit reported by the instance to the pager.
+func PrintRateLimit(settings Settings, rateLimit model.RateLimit) error {
+	return renderTemplateToPager(settings, "rateLimit", "", rateLimit)
+}
+
+// PrintResponseCache prints the list of cached responses to the pager.
+func PrintResponseCache(settings Settings, cache model.ResponseCache) error {
+	return renderTemplateToPager(settings, "responseCache", "", cache)
+}
+
+// PrintMediaCache prints the summary of the media cache of each instance to the pager.
+func PrintMediaCache(settings Settings, cache model.MediaCache) error {
+	return renderTemplateToPager(settings, "mediaCache", "", cache)
+}
+
 // PrintTag prints the details of the tag to the pager.
 func PrintTag(settings Settings, tag model.Tag) error {
 	return renderTemplateToPager(settings, "tag", "", tag)
@@ -174,7 +303,7 @@ func PrintTag(settings Settings, tag model.Tag) error {
 
 // PrintTagList prints the list of tags to the pager.
 func PrintTagList(settings Settings, list model.TagList) error {
-	return renderTemplateToPager(settings, "tagList", "", list)
+	return renderListToPager(settings, "tagList", "", list, list.Tags)
 }
 
 // PrintThread prints the thread to the pager.
@@ -189,7 +318,7 @@ func PrintList(settings Settings, list model.List) error {
 
 // PrintLists prints the set of lists to the pager.
 func PrintLists(settings Settings, lists []model.List) error {
-	return renderTemplateToPager(settings, "listOflist", "", lists)
+	return renderListToPager(settings, "listOflist", "", lists, lists)
 }
 
 // PrintNotification prints the details of the notification to the pager.
@@ -198,13 +327,38 @@ func PrintNotification(settings Settings, notification model.Notification, myAcc
 }
 
 // PrintNotificationList prints the list of notifications to the pager.
-func PrintNotificationList(settings Settings, list []model.Notification, myAccountID string) error {
-	return renderTemplateToPager(settings, "notificationList", myAccountID, list)
+func PrintNotificationList(settings Settings, list model.NotificationList, myAccountID string) error {
+	return renderListToPager(settings, "notificationList", myAccountID, list, list.Notifications)
+}
+
+// PrintNotificationGroupList prints the list of grouped notifications to the pager.
+func PrintNotificationGroupList(settings Settings, list model.NotificationGroupList, myAccountID string) error {
+	return renderListToPager(settings, "notificationGroupList", myAccountID, list, list.Groups)
+}
+
+// PrintNotificationPolicy prints your notification policy to the pager.
+func PrintNotificationPolicy(settings Settings, policy model.NotificationPolicy) error {
+	return renderTemplateToPager(settings, "notificationPolicyDoc", "", policy)
+}
+
+// PrintNotificationRequest prints the details of the notification request to the pager.
+func PrintNotificationRequest(settings Settings, request model.NotificationRequest) error {
+	return renderTemplateToPager(settings, "notificationRequestDoc", "", request)
+}
+
+// PrintNotificationRequestList prints the list of notification requests to the pager.
+func PrintNotificationRequestList(settings Settings, list model.NotificationRequestList) error {
+	return renderListToPager(settings, "notificationRequestList", "", list, list.Requests)
+}
+
+// PrintStreamEvent prints the event received from the stream to standard output.
+func PrintStreamEvent(settings Settings, event model.StreamEvent, myAccountID string) error {
+	return renderTemplateToStdout(settings, "streamEvent", myAccountID, event)
 }
 
 // PrintTokenList prints the list of tokens to the pager.
 func PrintTokenList(settings Settings, list model.TokenList) error {
-	return renderTemplateToPager(settings, "tokenList", "", list)
+	return renderListToPager(settings, "tokenList", "", list, list.Tokens)
 }
 
 // PrintToken prints the details of the token.
@@ -219,7 +373,7 @@ func PrintAliases(settings Settings, aliases map[string]string) error {
 
 // PrintFilters prints the user's list of filters.
 func PrintFilters(settings Settings, filters []model.FilterV2) error {
-	return renderTemplateToPager(settings, "filterList", "", filters)
+	return renderListToPager(settings, "filterList", "", filters, filters)
 }
 
 // PrintFilter prints the details of a filter.
@@ -237,7 +391,40 @@ func PrintFilterStatus(settings Settings, filterStatus model.FilterStatus) error
 	return renderTemplateToPager(settings, "filter-status", "", filterStatus)
 }
 
+// RenderStatusCard renders the status card and returns the text.
+// The minimized status card is rendered if the status is filtered with a warning
+// and nothing is rendered if the status is hidden by a filter.
+func RenderStatusCard(settings Settings, status model.Status, myAccountID string) (string, error) {
+	var templateName string
+
+	switch statusFilterAction(status.Filtered) {
+	case model.FilterActionHide:
+		return "", nil
+	case model.FilterActionWarn:
+		templateName = "statusCardMinimized"
+	default:
+		templateName = "statusCard"
+	}
+
+	return renderTemplateToString(settings, templateName, myAccountID, status)
+}
+
+// StatusHidden returns true if the status is hidden by one of your filters.
+// Hidden statuses are not displayed in feeds.
+func StatusHidden(status model.Status) bool {
+	return statusFilterAction(status.Filtered) == model.FilterActionHide
+}
+
+// RenderNotificationCard renders the notification card and returns the text.
+func RenderNotificationCard(settings Settings, notification model.Notification, myAccountID string) (string, error) {
+	return renderTemplateToString(settings, "notificationCard", myAccountID, notification)
+}
+
 func renderTemplateToPager(settings Settings, templateName, myAccountID string, data any) error {
+	if !settings.TextOutput() {
+		return renderJSON(settings, data)
+	}
+
 	if settings.pager == "" {
 		return renderTemplateToStdout(
 			settings,
@@ -297,6 +484,10 @@ func renderTemplateToStdout(
 	myAccountID string,
 	data any,
 ) error {
+	if !settings.TextOutput() {
+		return renderJSON(settings, data)
+	}
+
 	return renderTemplate(
 		os.Stdout,
 		settings,
@@ -306,6 +497,27 @@ func renderTemplateToStdout(
 	)
 }
 
+func renderTemplateToString(
+	settings Settings,
+	templateName string,
+	myAccountID string,
+	data any,
+) (string, error) {
+	var builder strings.Builder
+
+	if err := renderTemplate(
+		&builder,
+		settings,
+		templateName,
+		myAccountID,
+		data,
+	); err != nil {
+		return "", err
+	}
+
+	return builder.String(), nil
+}
+
 func renderTemplate(
 	writer io.Writer,
 	settings Settings,
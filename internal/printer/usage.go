@@ -26,7 +26,7 @@ func PrintUsageRoot(
 	}
 
 	return renderTemplateToPager(
-		settings,
+		settings.withTextOutput(),
 		"usageRoot",
 		"",
 		data,
@@ -55,7 +55,7 @@ func PrintUsageTarget(
 	}
 
 	return renderTemplateToPager(
-		settings,
+		settings.withTextOutput(),
 		"usageTarget",
 		"",
 		data,
@@ -108,7 +108,7 @@ func PrintUsageOperation(
 	}
 
 	return renderTemplateToPager(
-		settings,
+		settings.withTextOutput(),
 		"usageOperation",
 		"",
 		data,
@@ -14,6 +14,8 @@ import (
 	"time"
 
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/media"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/notifier"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/sessions"
 	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
@@ -27,6 +29,8 @@ func Run(
 	socketPath string,
 	withoutIdleTimeout bool,
 	idleTimeout int,
+	serverNotifier *notifier.Notifier,
+	mediaCacheLimiter *media.CacheLimiter,
 ) error {
 	if socketPath == "" {
 		return SocketFileNotSpecifiedError{}
@@ -59,25 +63,32 @@ func Run(
 		return fmt.Errorf("error registering the GTSClient methods to the server: %w", err)
 	}
 
-	// Create the session store and register its methods to the RPC server
-	var sessionStore *sessions.SessionStore
-
-	if withoutIdleTimeout {
-		sessionStore = sessions.NewSessionStore(false)
-	} else {
-		sessionStore = sessions.NewSessionStore(true)
-	}
+	// Create the session store and register its methods to the RPC server.
+	// A server without an idle timeout only keeps track of the client sessions
+	// when it needs to know that it is idle to limit the size of the media cache.
+	sessionStore := sessions.NewSessionStore(!withoutIdleTimeout || mediaCacheLimiter != nil)
 
 	if err := server.Register(sessionStore); err != nil {
 		return fmt.Errorf("error registering the session store to the server: %w", err)
 	}
 
+	// Run the notifier in the background if it is enabled.
+	if serverNotifier != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go serverNotifier.Run(ctx)
+	}
+
 	if withoutIdleTimeout {
 		// Run the server without a timer.
 		return runWithoutIdleTimeout(
 			printSettings,
 			server,
 			socketPath,
+			idleTimeout,
+			sessionStore,
+			mediaCacheLimiter,
 		)
 	}
 
@@ -88,6 +99,7 @@ func Run(
 		socketPath,
 		idleTimeout,
 		sessionStore,
+		mediaCacheLimiter,
 	)
 }
 
@@ -99,6 +111,7 @@ func runWithIdleTimeout(
 	socketPath string,
 	idleTimeout int,
 	sessionStore *sessions.SessionStore,
+	mediaCacheLimiter *media.CacheLimiter,
 ) error {
 	listener, err := net.Listen("unix", socketPath)
 	if err != nil {
@@ -157,6 +170,7 @@ Outer:
 		case <-ticker.C:
 			if sessionStore.IsEmpty() {
 				printer.PrintInfo("Server idle timeout.\n")
+				limitMediaCache(printSettings, mediaCacheLimiter)
 
 				break Outer
 			}
@@ -173,10 +187,15 @@ Outer:
 }
 
 // runWithoutIdleTimeout runs the RPC server. The server closes when the shutdown signal is received.
+// The idle timeout is only used to schedule the tasks that are run while the server is idle, i.e.
+// when there are no client sessions in progress.
 func runWithoutIdleTimeout(
 	printSettings printer.Settings,
 	server *rpc.Server,
 	socketPath string,
+	idleTimeout int,
+	sessionStore *sessions.SessionStore,
+	mediaCacheLimiter *media.CacheLimiter,
 ) error {
 	listener, err := net.Listen("unix", socketPath)
 	if err != nil {
@@ -186,6 +205,15 @@ func runWithoutIdleTimeout(
 
 	printer.PrintInfo("Running the server using socket path: " + socketPath + "\n")
 
+	if idleTimeout < minIdleTimeout {
+		idleTimeout = minIdleTimeout
+	}
+
+	timeout := time.Duration(idleTimeout) * time.Second
+
+	ticker := time.NewTicker(timeout)
+	defer ticker.Stop()
+
 	// Listen and serve connections from the client in a separate goroutine.
 	go func() {
 		for {
@@ -205,6 +233,8 @@ func runWithoutIdleTimeout(
 				os.Exit(1)
 			}
 
+			ticker.Reset(timeout)
+
 			go server.ServeConn(conn)
 		}
 	}()
@@ -217,12 +247,42 @@ func runWithoutIdleTimeout(
 	)
 	defer stop()
 
-	<-ctx.Done()
-	stop()
+	for {
+		select {
+		case <-ticker.C:
+			if sessionStore.IsEmpty() {
+				limitMediaCache(printSettings, mediaCacheLimiter)
+			}
+		case <-ctx.Done():
+			stop()
+			printer.PrintInfo("\nShutdown signal received.\n")
 
-	printer.PrintInfo("\nShutdown signal received.\n")
+			return nil
+		}
+	}
+}
 
-	return nil
+// limitMediaCache removes the least recently viewed media files from the media
+// cache when it is larger than the configured limit. Nothing is done if the
+// limit is not configured.
+func limitMediaCache(printSettings printer.Settings, limiter *media.CacheLimiter) {
+	if limiter == nil {
+		return
+	}
+
+	report, err := limiter.Enforce()
+	if err != nil {
+		printer.PrintFailure(printSettings, "Error limiting the size of the media cache: "+err.Error()+".")
+
+		return
+	}
+
+	if report.RemovedFiles > 0 {
+		printer.PrintInfo(
+			"Removed " + strconv.Itoa(report.RemovedFiles) + " media files (" +
+				printer.FormatSize(report.RemovedSize) + ") from the media cache.\n",
+		)
+	}
 }
 
 // removeUnusedSocketFile removes the socket file if it already exists and
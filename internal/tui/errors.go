@@ -0,0 +1,17 @@
+package tui
+
+type NotATerminalError struct {
+	err error
+}
+
+func (e NotATerminalError) Error() string {
+	return "the terminal user interface can only run in an interactive terminal: " + e.err.Error()
+}
+
+type UnknownViewError struct {
+	view string
+}
+
+func (e UnknownViewError) Error() string {
+	return "unknown view: " + e.view
+}
@@ -0,0 +1,55 @@
+package tui
+
+import "codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+
+// The following unexported types, functions and values are exported for the tests in the tui_test package.
+type (
+	Key     = key
+	KeyCode = keyCode
+)
+
+const (
+	KeyRune      = keyRune
+	KeyUp        = keyUp
+	KeyDown      = keyDown
+	KeyPageUp    = keyPageUp
+	KeyPageDown  = keyPageDown
+	KeyEnter     = keyEnter
+	KeyEscape    = keyEscape
+	KeyBackspace = keyBackspace
+	KeyInterrupt = keyInterrupt
+	KeyUnknown   = keyUnknown
+)
+
+var (
+	ParseKeys = parseKeys
+	Truncate  = truncate
+)
+
+func NewKey(code KeyCode, r rune) Key {
+	return key{code: code, r: r}
+}
+
+// StatusItemIDs returns the IDs of the statuses that are added to a view.
+func StatusItemIDs(statuses []model.Status) []string {
+	return itemIDs(appendStatusItems(nil, statuses))
+}
+
+// NotificationItemIDs returns the IDs of the notifications that are added to a view.
+func NotificationItemIDs(notifications []model.Notification) []string {
+	return itemIDs(appendNotificationItems(nil, notifications))
+}
+
+func itemIDs(items []item) []string {
+	ids := make([]string, len(items))
+
+	for idx := range items {
+		if items[idx].notification != nil {
+			ids[idx] = items[idx].notification.ID
+		} else {
+			ids[idx] = items[idx].status.ID
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	escEnterAltScreen = "\033[?1049h"
+	escExitAltScreen  = "\033[?1049l"
+	escHideCursor     = "\033[?25l"
+	escShowCursor     = "\033[?25h"
+	escClearLine      = "\033[2K"
+	escReset          = "\033[0m"
+	escReverse        = "\033[7m"
+	escBoldBlue       = "\033[34;1m"
+)
+
+type keyCode int
+
+const (
+	keyRune keyCode = iota
+	keyUp
+	keyDown
+	keyPageUp
+	keyPageDown
+	keyEnter
+	keyEscape
+	keyBackspace
+	keyInterrupt
+	keyUnknown
+)
+
+type key struct {
+	code keyCode
+	r    rune
+}
+
+// terminal is the interactive terminal that the user interface is drawn on.
+// The terminal is switched to the non-canonical mode with echo disabled so that
+// the key presses are received as soon as they are typed. The original settings
+// are restored when the user interface exits.
+type terminal struct {
+	originalState string
+	restored      bool
+}
+
+func newTerminal() (*terminal, error) {
+	state, err := stty("-g")
+	if err != nil {
+		return nil, NotATerminalError{err: err}
+	}
+
+	if _, err := stty("-icanon", "-echo", "-isig", "min", "1"); err != nil {
+		return nil, fmt.Errorf("unable to set the terminal to the non-canonical mode: %w", err)
+	}
+
+	term := terminal{
+		originalState: strings.TrimSpace(state),
+		restored:      false,
+	}
+
+	term.write(escEnterAltScreen + escHideCursor)
+
+	return &term, nil
+}
+
+// restore restores the original settings of the terminal.
+// Nothing is done if the terminal has already been restored.
+func (t *terminal) restore() error {
+	if t.restored {
+		return nil
+	}
+
+	t.restored = true
+
+	t.write(escShowCursor + escExitAltScreen)
+
+	if _, err := stty(t.originalState); err != nil {
+		return fmt.Errorf("unable to restore the terminal settings: %w", err)
+	}
+
+	return nil
+}
+
+// size returns the number of rows and columns of the terminal.
+func (t *terminal) size() (int, int) {
+	const (
+		defaultRows = 24
+		defaultCols = 80
+	)
+
+	output, err := stty("size")
+	if err != nil {
+		return defaultRows, defaultCols
+	}
+
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return defaultRows, defaultCols
+	}
+
+	rows, err := strconv.Atoi(fields[0])
+	if err != nil || rows <= 0 {
+		rows = defaultRows
+	}
+
+	cols, err := strconv.Atoi(fields[1])
+	if err != nil || cols <= 0 {
+		cols = defaultCols
+	}
+
+	return rows, cols
+}
+
+func (t *terminal) write(text string) {
+	_, _ = os.Stdout.WriteString(text)
+}
+
+// readKeys blocks until the user presses a key and returns the keys
+// that were read from the input.
+func (t *terminal) readKeys() ([]key, error) {
+	buf := make([]byte, 64)
+
+	n, err := os.Stdin.Read(buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read from the terminal: %w", err)
+	}
+
+	return parseKeys(buf[:n]), nil
+}
+
+func parseKeys(input []byte) []key {
+	keys := make([]key, 0)
+
+	for len(input) > 0 {
+		switch {
+		case input[0] == '\033':
+			parsed, size := parseEscapeSequence(input)
+			keys = append(keys, parsed)
+			input = input[size:]
+
+			continue
+		case input[0] == '\r' || input[0] == '\n':
+			keys = append(keys, key{code: keyEnter, r: 0})
+		case input[0] == 0x7f || input[0] == '\b':
+			keys = append(keys, key{code: keyBackspace, r: 0})
+		case input[0] == 0x03:
+			keys = append(keys, key{code: keyInterrupt, r: 0})
+		default:
+			r, size := utf8.DecodeRune(input)
+			keys = append(keys, key{code: keyRune, r: r})
+			input = input[size:]
+
+			continue
+		}
+
+		input = input[1:]
+	}
+
+	return keys
+}
+
+func parseEscapeSequence(input []byte) (key, int) {
+	if len(input) == 1 || input[1] != '[' {
+		return key{code: keyEscape, r: 0}, 1
+	}
+
+	sequences := map[string]keyCode{
+		"\033[A":  keyUp,
+		"\033[B":  keyDown,
+		"\033[5~": keyPageUp,
+		"\033[6~": keyPageDown,
+	}
+
+	for sequence, code := range sequences {
+		if strings.HasPrefix(string(input), sequence) {
+			return key{code: code, r: 0}, len(sequence)
+		}
+	}
+
+	// Skip over any other control sequence.
+	for idx := 2; idx < len(input); idx++ {
+		if input[idx] >= 0x40 && input[idx] <= 0x7e {
+			return key{code: keyUnknown, r: 0}, idx + 1
+		}
+	}
+
+	return key{code: keyUnknown, r: 0}, len(input)
+}
+
+// truncate truncates the line so that it fits within the specified width.
+// ANSI escape sequences are not counted towards the width of the line.
+func truncate(line string, width int) string {
+	var (
+		builder strings.Builder
+		visible int
+		inEsc   bool
+	)
+
+	for _, r := range line {
+		switch {
+		case inEsc:
+			builder.WriteRune(r)
+
+			if r >= 0x40 && r <= 0x7e && r != '[' {
+				inEsc = false
+			}
+		case r == '\033':
+			inEsc = true
+
+			builder.WriteRune(r)
+		default:
+			if visible >= width {
+				continue
+			}
+
+			builder.WriteRune(r)
+
+			visible++
+		}
+	}
+
+	return builder.String()
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("error running stty: %w", err)
+	}
+
+	return string(output), nil
+}
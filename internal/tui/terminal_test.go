@@ -0,0 +1,152 @@
+package tui_test
+
+import (
+	"slices"
+	"testing"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/tui"
+)
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		input string
+		want  []tui.Key
+	}{
+		{
+			name:  "Letters",
+			input: "jk",
+			want:  []tui.Key{tui.NewKey(tui.KeyRune, 'j'), tui.NewKey(tui.KeyRune, 'k')},
+		},
+		{
+			name:  "Multi-byte character",
+			input: "é",
+			want:  []tui.Key{tui.NewKey(tui.KeyRune, 'é')},
+		},
+		{
+			name:  "Enter (carriage return)",
+			input: "\r",
+			want:  []tui.Key{tui.NewKey(tui.KeyEnter, 0)},
+		},
+		{
+			name:  "Enter (line feed)",
+			input: "\n",
+			want:  []tui.Key{tui.NewKey(tui.KeyEnter, 0)},
+		},
+		{
+			name:  "Backspace",
+			input: "\x7f\b",
+			want:  []tui.Key{tui.NewKey(tui.KeyBackspace, 0), tui.NewKey(tui.KeyBackspace, 0)},
+		},
+		{
+			name:  "Interrupt",
+			input: "\x03",
+			want:  []tui.Key{tui.NewKey(tui.KeyInterrupt, 0)},
+		},
+		{
+			name:  "Escape",
+			input: "\033",
+			want:  []tui.Key{tui.NewKey(tui.KeyEscape, 0)},
+		},
+		{
+			name:  "Arrow keys",
+			input: "\033[A\033[B",
+			want:  []tui.Key{tui.NewKey(tui.KeyUp, 0), tui.NewKey(tui.KeyDown, 0)},
+		},
+		{
+			name:  "Page up and page down",
+			input: "\033[5~\033[6~",
+			want:  []tui.Key{tui.NewKey(tui.KeyPageUp, 0), tui.NewKey(tui.KeyPageDown, 0)},
+		},
+		{
+			name:  "Unknown control sequence followed by a letter",
+			input: "\033[1;5Cq",
+			want:  []tui.Key{tui.NewKey(tui.KeyUnknown, 0), tui.NewKey(tui.KeyRune, 'q')},
+		},
+		{
+			name:  "Incomplete control sequence",
+			input: "\033[1;",
+			want:  []tui.Key{tui.NewKey(tui.KeyUnknown, 0)},
+		},
+		{
+			name:  "Escape followed by a letter",
+			input: "\033q",
+			want:  []tui.Key{tui.NewKey(tui.KeyEscape, 0), tui.NewKey(tui.KeyRune, 'q')},
+		},
+		{
+			name:  "No input",
+			input: "",
+			want:  []tui.Key{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tui.ParseKeys([]byte(tc.input)); !slices.Equal(got, tc.want) {
+				t.Errorf("Unexpected keys parsed from %q: want %v, got %v", tc.input, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		line  string
+		width int
+		want  string
+	}{
+		{
+			name:  "Shorter than the width",
+			line:  "hello",
+			width: 10,
+			want:  "hello",
+		},
+		{
+			name:  "Longer than the width",
+			line:  "hello world",
+			width: 5,
+			want:  "hello",
+		},
+		{
+			name:  "Multi-byte characters",
+			line:  "héllo wörld",
+			width: 7,
+			want:  "héllo w",
+		},
+		{
+			name:  "Escape sequences are not counted",
+			line:  "\033[34;1mhello\033[0m world",
+			width: 5,
+			want:  "\033[34;1mhello\033[0m",
+		},
+		{
+			name:  "Escape sequences after the width are kept",
+			line:  "hello \033[7mworld\033[0m",
+			width: 3,
+			want:  "hel\033[7m\033[0m",
+		},
+		{
+			name:  "Zero width",
+			line:  "hello",
+			width: 0,
+			want:  "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tui.Truncate(tc.line, tc.width); got != tc.want {
+				t.Errorf("Unexpected truncated line: want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,529 @@
+package tui
+
+import (
+	"fmt"
+	"net/rpc"
+	"strconv"
+	"strings"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/config"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/media"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/utilities"
+)
+
+const (
+	// The number of lines reserved for the title bar, the message line and the help line.
+	reservedLines = 3
+
+	// The number of columns reserved for the selection marker.
+	markerWidth = 2
+
+	helpText = "j/k move  enter thread  f like  b boost  m bookmark  r reply  o media  " +
+		"n more  R refresh  H/P/L/T/N views  esc back  q quit"
+)
+
+// StartArgs is the set of arguments used to select the first view
+// that is displayed when the user interface starts.
+type StartArgs struct {
+	View    string
+	ListID  string
+	TagName string
+}
+
+type app struct {
+	client        *rpc.Client
+	cfg           config.Config
+	printSettings printer.Settings
+	term          *terminal
+	myAccountID   string
+	views         []*view
+	width         int
+	message       string
+	quit          bool
+}
+
+// Run runs the interactive terminal user interface until the user quits.
+func Run(
+	client *rpc.Client,
+	cfg config.Config,
+	printSettings printer.Settings,
+	args StartArgs,
+) error {
+	var myAccountID string
+	if err := client.Call("GTSClient.GetMyAccountID", gtsclient.NoRPCArgs{}, &myAccountID); err != nil {
+		return fmt.Errorf("unable to get your account ID: %w", err)
+	}
+
+	start := newView(args.View, args.ListID, args.TagName, "")
+	if err := start.load(client, false); err != nil {
+		return err
+	}
+
+	term, err := newTerminal()
+	if err != nil {
+		return err
+	}
+
+	// Restore the terminal if the user interface panics so that
+	// the user's shell is not left in the non-canonical mode.
+	defer func() {
+		_ = term.restore()
+	}()
+
+	tui := app{
+		client:        client,
+		cfg:           cfg,
+		printSettings: printSettings,
+		term:          term,
+		myAccountID:   myAccountID,
+		views:         []*view{start},
+		width:         0,
+		message:       "",
+		quit:          false,
+	}
+
+	runErr := tui.run()
+
+	if err := term.restore(); err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+func (a *app) run() error {
+	for !a.quit {
+		if err := a.draw(); err != nil {
+			return err
+		}
+
+		keys, err := a.term.readKeys()
+		if err != nil {
+			return err
+		}
+
+		for _, pressed := range keys {
+			a.message = ""
+
+			if err := a.handleKey(pressed); err != nil {
+				a.message = "Error: " + err.Error()
+			}
+
+			if a.quit {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *app) currentView() *view {
+	return a.views[len(a.views)-1]
+}
+
+// draw draws the current view on the terminal.
+func (a *app) draw() error {
+	rows, cols := a.term.size()
+	current := a.currentView()
+
+	// Re-render all the views if the width of the terminal changed.
+	if cols != a.width {
+		a.width = cols
+
+		for idx := range a.views {
+			a.views[idx].render(a.settings(), a.myAccountID) //nolint:errcheck
+		}
+	}
+
+	for idx := range current.items {
+		if current.items[idx].lines == nil {
+			if err := current.items[idx].render(a.settings(), a.myAccountID); err != nil {
+				return err
+			}
+		}
+	}
+
+	height := max(1, rows-reservedLines)
+	current.scroll(height)
+
+	lines := make([]string, 0, height)
+
+	for idx := current.top; idx < len(current.items) && len(lines) < height; idx++ {
+		marker := "  "
+		if idx == current.selected {
+			marker = escBoldBlue + "┃ " + escReset
+		}
+
+		for _, line := range current.items[idx].lines {
+			if len(lines) == height {
+				break
+			}
+
+			lines = append(lines, marker+line)
+		}
+	}
+
+	if len(current.items) == 0 {
+		lines = append(lines, "  There is nothing to show here.")
+	}
+
+	var builder strings.Builder
+
+	title := fmt.Sprintf(" Enbas • %s (%d/%d) ", current.title, current.selected+1, len(current.items))
+	if len(current.items) == 0 {
+		title = fmt.Sprintf(" Enbas • %s ", current.title)
+	}
+
+	builder.WriteString(moveTo(1) + escClearLine + escReverse + truncate(title+strings.Repeat(" ", cols), cols) + escReset)
+
+	for idx := range height {
+		builder.WriteString(moveTo(idx+2) + escClearLine)
+
+		if idx < len(lines) {
+			builder.WriteString(truncate(lines[idx], cols) + escReset)
+		}
+	}
+
+	builder.WriteString(moveTo(rows-1) + escClearLine + truncate(a.message, cols))
+	builder.WriteString(moveTo(rows) + escClearLine + escReverse + truncate(helpText+strings.Repeat(" ", cols), cols) + escReset)
+
+	a.term.write(builder.String())
+
+	return nil
+}
+
+func (a *app) settings() printer.Settings {
+	return a.printSettings.WithTextOutput(a.width - markerWidth)
+}
+
+func (a *app) handleKey(pressed key) error {
+	current := a.currentView()
+
+	switch pressed.code {
+	case keyUp:
+		current.moveSelection(-1)
+	case keyDown:
+		current.moveSelection(1)
+	case keyPageUp:
+		current.moveSelection(-pageLimit / 2)
+	case keyPageDown:
+		current.moveSelection(pageLimit / 2)
+	case keyEnter:
+		return a.openThread()
+	case keyEscape, keyBackspace:
+		a.back()
+	case keyInterrupt:
+		a.quit = true
+	case keyRune:
+		return a.handleRune(pressed.r)
+	case keyUnknown:
+	}
+
+	return nil
+}
+
+func (a *app) handleRune(r rune) error {
+	current := a.currentView()
+
+	switch r {
+	case 'j':
+		current.moveSelection(1)
+	case 'k':
+		current.moveSelection(-1)
+	case 'g':
+		current.selected = 0
+	case 'G':
+		current.moveSelection(len(current.items))
+	case 'q':
+		if len(a.views) > 1 {
+			a.back()
+		} else {
+			a.quit = true
+		}
+	case 'f':
+		return a.toggle("GTSClient.LikeStatus", "GTSClient.UnlikeStatus", func(s model.Status) bool { return s.Favourited })
+	case 'b':
+		return a.toggle("GTSClient.ReblogStatus", "GTSClient.UnreblogStatus", func(s model.Status) bool { return s.Reblogged })
+	case 'm':
+		return a.toggle(
+			"GTSClient.AddStatusToBookmarks",
+			"GTSClient.RemoveStatusFromBookmarks",
+			func(s model.Status) bool { return s.Bookmarked },
+		)
+	case 'r':
+		return a.reply()
+	case 'o':
+		return a.openMedia()
+	case 'n':
+		a.message = "Loading..."
+
+		return current.load(a.client, true)
+	case 'R':
+		return current.load(a.client, false)
+	case 'H':
+		return a.switchView(newView(ViewHomeTimeline, "", "", ""))
+	case 'P':
+		return a.switchView(newView(ViewPublicTimeline, "", "", ""))
+	case 'N':
+		return a.switchView(newView(ViewNotifications, "", "", ""))
+	case 'L':
+		listID, ok := a.prompt("List ID: ", "")
+		if !ok || listID == "" {
+			return nil
+		}
+
+		return a.switchView(newView(ViewListTimeline, listID, "", ""))
+	case 'T':
+		tagName, ok := a.prompt("Tag name: #", "")
+		if !ok || tagName == "" {
+			return nil
+		}
+
+		return a.switchView(newView(ViewTagTimeline, "", strings.TrimPrefix(tagName, "#"), ""))
+	}
+
+	return nil
+}
+
+// switchView replaces all the views with the new view.
+func (a *app) switchView(newView *view) error {
+	if err := newView.load(a.client, false); err != nil {
+		return err
+	}
+
+	a.views = []*view{newView}
+
+	return nil
+}
+
+func (a *app) back() {
+	if len(a.views) > 1 {
+		a.views = a.views[:len(a.views)-1]
+	}
+}
+
+// selectedStatus returns the status of the selected item. The original status is
+// returned if the selected status is a boost.
+func (a *app) selectedStatus() (*item, model.Status, bool) {
+	selected, ok := a.currentView().selectedItem()
+	if !ok || selected.status == nil {
+		a.message = "There is no status selected."
+
+		return nil, model.Status{}, false
+	}
+
+	status := *selected.status
+
+	// Retrieve the original status if the selected status is a boost.
+	if status.Reblog.ID != "" {
+		if err := a.client.Call("GTSClient.GetStatus", status.Reblog.ID, &status); err != nil {
+			a.message = "Error: unable to retrieve the boosted status: " + err.Error()
+
+			return nil, model.Status{}, false
+		}
+	}
+
+	return selected, status, true
+}
+
+// toggle calls the undo method if the selected status is marked, otherwise
+// it calls the do method. The selected status is then refreshed.
+func (a *app) toggle(doMethod, undoMethod string, marked func(model.Status) bool) error {
+	selected, status, ok := a.selectedStatus()
+	if !ok {
+		return nil
+	}
+
+	method := doMethod
+	if marked(status) {
+		method = undoMethod
+	}
+
+	if err := a.client.Call(method, status.ID, nil); err != nil {
+		return fmt.Errorf("unable to update the status: %w", err)
+	}
+
+	return a.refresh(selected)
+}
+
+// refresh retrieves the latest version of the item's status from the instance.
+func (a *app) refresh(selected *item) error {
+	var status model.Status
+	if err := a.client.Call("GTSClient.GetStatus", selected.status.ID, &status); err != nil {
+		return fmt.Errorf("unable to retrieve the status: %w", err)
+	}
+
+	*selected.status = status
+
+	return selected.render(a.settings(), a.myAccountID)
+}
+
+func (a *app) openThread() error {
+	_, status, ok := a.selectedStatus()
+	if !ok {
+		return nil
+	}
+
+	thread := newView(viewThread, "", "", status.ID)
+	if err := thread.load(a.client, false); err != nil {
+		return err
+	}
+
+	a.views = append(a.views, thread)
+
+	return nil
+}
+
+func (a *app) reply() error {
+	selected, status, ok := a.selectedStatus()
+	if !ok {
+		return nil
+	}
+
+	mention := ""
+	if status.Account.ID != a.myAccountID {
+		mention = "@" + status.Account.Acct + " "
+	}
+
+	content, ok := a.prompt("Reply: ", mention)
+	if !ok || strings.TrimSpace(content) == "" {
+		a.message = "Reply cancelled."
+
+		return nil
+	}
+
+	form := gtsclient.CreateStatusForm{
+		Content:           content,
+		InReplyTo:         status.ID,
+		Language:          status.Language,
+		SpoilerText:       status.SpoilerText,
+		Boostable:         true,
+		LocalOnly:         false,
+		Likeable:          true,
+		Replyable:         true,
+		Sensitive:         status.Sensitive,
+		Poll:              nil,
+		ContentType:       "text/plain",
+		Visibility:        status.Visibility,
+		AttachmentIDs:     nil,
+		ScheduledAt:       "",
+		InteractionPolicy: nil,
+	}
+
+	var reply model.Status
+	if err := a.client.Call("GTSClient.CreateStatus", form, &reply); err != nil {
+		return fmt.Errorf("unable to create the reply: %w", err)
+	}
+
+	a.message = "Successfully created the reply with ID: " + reply.ID
+
+	return a.refresh(selected)
+}
+
+func (a *app) openMedia() error {
+	_, status, ok := a.selectedStatus()
+	if !ok {
+		return nil
+	}
+
+	if len(status.MediaAttachments) == 0 {
+		a.message = "The selected status has no media attachments."
+
+		return nil
+	}
+
+	var instanceURL string
+	if err := a.client.Call("GTSClient.GetInstanceURL", gtsclient.NoRPCArgs{}, &instanceURL); err != nil {
+		return fmt.Errorf("unable to retrieve the instance URL: %w", err)
+	}
+
+	cacheDir, err := utilities.CalculateMediaCacheDir(a.cfg.CacheDirectory, instanceURL)
+	if err != nil {
+		return fmt.Errorf("unable to calculate the media cache directory: %w", err)
+	}
+
+	if err := utilities.EnsureDirectory(cacheDir); err != nil {
+		return fmt.Errorf("unable to ensure the existence of the directory %q: %w", cacheDir, err)
+	}
+
+	a.message = "Downloading " + strconv.Itoa(len(status.MediaAttachments)) + " media attachment(s)..."
+	if err := a.draw(); err != nil {
+		return err
+	}
+
+	bundle := media.NewBundle(cacheDir, status.MediaAttachments, true, true, true, nil)
+
+	if err := bundle.Download(a.client); err != nil {
+		return fmt.Errorf("unable to download the media: %w", err)
+	}
+
+	players := []struct {
+		program string
+		files   []string
+	}{
+		{program: a.cfg.Integrations.ImageViewer, files: bundle.ImageFiles()},
+		{program: a.cfg.Integrations.VideoPlayer, files: bundle.VideoFiles()},
+		{program: a.cfg.Integrations.AudioPlayer, files: bundle.AudioFiles()},
+	}
+
+	for _, player := range players {
+		if len(player.files) == 0 {
+			continue
+		}
+
+		if err := utilities.OpenMedia(player.program, player.files); err != nil {
+			return fmt.Errorf("unable to open the media: %w", err)
+		}
+	}
+
+	a.message = "Opened the media attachments."
+
+	return nil
+}
+
+// prompt reads a line of text from the user on the message line. False is returned
+// if the user cancels the prompt with the Escape key.
+func (a *app) prompt(label, initial string) (string, bool) {
+	input := []rune(initial)
+
+	for {
+		rows, cols := a.term.size()
+
+		a.term.write(moveTo(rows-1) + escClearLine + escShowCursor + truncate(label+string(input), cols))
+
+		keys, err := a.term.readKeys()
+		if err != nil {
+			a.term.write(escHideCursor)
+
+			return "", false
+		}
+
+		for _, pressed := range keys {
+			switch pressed.code {
+			case keyEnter:
+				a.term.write(escHideCursor)
+
+				return string(input), true
+			case keyEscape, keyInterrupt:
+				a.term.write(escHideCursor)
+
+				return "", false
+			case keyBackspace:
+				if len(input) > 0 {
+					input = input[:len(input)-1]
+				}
+			case keyRune:
+				input = append(input, pressed.r)
+			case keyUp, keyDown, keyPageUp, keyPageDown, keyUnknown:
+			}
+		}
+	}
+}
+
+func moveTo(row int) string {
+	return "\033[" + strconv.Itoa(row) + ";1H"
+}
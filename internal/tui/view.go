@@ -0,0 +1,284 @@
+package tui
+
+import (
+	"fmt"
+	"net/rpc"
+	"strings"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/gtsclient"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/printer"
+)
+
+const (
+	ViewHomeTimeline   string = "home"
+	ViewPublicTimeline string = "public"
+	ViewListTimeline   string = "list"
+	ViewTagTimeline    string = "tag"
+	ViewNotifications  string = "notifications"
+	viewThread         string = "thread"
+
+	pageLimit = 20
+)
+
+// item is a single entry in a view. Each item is either a status
+// or a notification. The status is set for notifications that
+// are related to a status.
+type item struct {
+	status       *model.Status
+	notification *model.Notification
+	lines        []string
+}
+
+// view is a scrollable list of statuses or notifications.
+type view struct {
+	kind      string
+	title     string
+	listID    string
+	tagName   string
+	statusID  string
+	items     []item
+	selected  int
+	top       int
+	nextMaxID string
+}
+
+func newView(kind, listID, tagName, statusID string) *view {
+	return &view{
+		kind:      kind,
+		title:     "",
+		listID:    listID,
+		tagName:   tagName,
+		statusID:  statusID,
+		items:     make([]item, 0),
+		selected:  0,
+		top:       0,
+		nextMaxID: "",
+	}
+}
+
+// load retrieves the items of the view from the instance. If more is set to true
+// the next page of items is appended to the view, otherwise the view is reloaded.
+func (v *view) load(client *rpc.Client, more bool) error {
+	pagination := gtsclient.PaginationArgs{
+		Limit:   pageLimit,
+		MaxID:   "",
+		MinID:   "",
+		SinceID: "",
+		All:     false,
+	}
+
+	if more {
+		if v.nextMaxID == "" {
+			return nil
+		}
+
+		pagination.MaxID = v.nextMaxID
+	}
+
+	var (
+		statuses   model.StatusList
+		items      []item
+		nextMaxID  string
+		selected   = 0
+		err        error
+		pageLoaded = true
+	)
+
+	switch v.kind {
+	case ViewHomeTimeline:
+		v.title = "Home timeline"
+		err = client.Call("GTSClient.GetHomeTimeline", pagination, &statuses)
+	case ViewPublicTimeline:
+		v.title = "Public timeline"
+		err = client.Call("GTSClient.GetPublicTimeline", pagination, &statuses)
+	case ViewListTimeline:
+		var list model.List
+
+		if err := client.Call("GTSClient.GetList", v.listID, &list); err != nil {
+			return fmt.Errorf("unable to retrieve the list: %w", err)
+		}
+
+		v.title = "List: " + list.Title
+		err = client.Call(
+			"GTSClient.GetListTimeline",
+			gtsclient.GetListTimelineArgs{
+				ListID:     list.ID,
+				Title:      list.Title,
+				Pagination: pagination,
+			},
+			&statuses,
+		)
+	case ViewTagTimeline:
+		v.title = "Tag: #" + v.tagName
+		err = client.Call(
+			"GTSClient.GetTagTimeline",
+			gtsclient.GetTagTimelineArgs{
+				TagName:    v.tagName,
+				Pagination: pagination,
+			},
+			&statuses,
+		)
+	case ViewNotifications:
+		var notifications model.NotificationList
+
+		v.title = "Notifications"
+		pageLoaded = false
+
+		if err := client.Call(
+			"GTSClient.GetNotificationList",
+			gtsclient.GetNotificationListArgs{
+				Pagination:   pagination,
+				IncludeTypes: nil,
+				ExcludeTypes: nil,
+			},
+			&notifications,
+		); err != nil {
+			return fmt.Errorf("unable to retrieve the notifications: %w", err)
+		}
+
+		items = appendNotificationItems(items, notifications.Notifications)
+		nextMaxID = notifications.Pagination.NextMaxID
+	case viewThread:
+		var thread model.Thread
+
+		v.title = "Thread"
+		pageLoaded = false
+
+		if err := client.Call("GTSClient.GetThread", v.statusID, &thread); err != nil {
+			return fmt.Errorf("unable to retrieve the thread: %w", err)
+		}
+
+		items = appendStatusItems(items, thread.Ancestors.Statuses)
+		selected = len(items)
+		items = appendStatusItems(items, []model.Status{thread.Context})
+		items = appendStatusItems(items, thread.Descendants.Statuses)
+	default:
+		return UnknownViewError{view: v.kind}
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the timeline: %w", err)
+	}
+
+	if pageLoaded {
+		items = appendStatusItems(items, statuses.Statuses)
+		nextMaxID = statuses.Pagination.NextMaxID
+	}
+
+	v.nextMaxID = nextMaxID
+
+	if more {
+		v.items = append(v.items, items...)
+
+		return nil
+	}
+
+	v.items = items
+	v.selected = selected
+	v.top = 0
+
+	return nil
+}
+
+// appendStatusItems appends the statuses to the list of items.
+// Statuses that are hidden by the user's filters are skipped.
+func appendStatusItems(items []item, statuses []model.Status) []item {
+	for idx := range statuses {
+		if printer.StatusHidden(statuses[idx]) {
+			continue
+		}
+
+		items = append(items, item{
+			status:       &statuses[idx],
+			notification: nil,
+			lines:        nil,
+		})
+	}
+
+	return items
+}
+
+// appendNotificationItems appends the notifications to the list of items.
+// Notifications about statuses that are hidden by the user's filters are skipped.
+func appendNotificationItems(items []item, notifications []model.Notification) []item {
+	for idx := range notifications {
+		if status := notifications[idx].Status; status != nil && printer.StatusHidden(*status) {
+			continue
+		}
+
+		items = append(items, item{
+			status:       notifications[idx].Status,
+			notification: &notifications[idx],
+			lines:        nil,
+		})
+	}
+
+	return items
+}
+
+// render renders the lines of every item in the view.
+func (v *view) render(settings printer.Settings, myAccountID string) error {
+	for idx := range v.items {
+		if err := v.items[idx].render(settings, myAccountID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *item) render(settings printer.Settings, myAccountID string) error {
+	var (
+		text string
+		err  error
+	)
+
+	if i.notification != nil {
+		text, err = printer.RenderNotificationCard(settings, *i.notification, myAccountID)
+	} else {
+		text, err = printer.RenderStatusCard(settings, *i.status, myAccountID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to render the item: %w", err)
+	}
+
+	i.lines = strings.Split(strings.Trim(text, "\n"), "\n")
+
+	return nil
+}
+
+// scroll ensures that the selected item is visible within the specified
+// number of lines.
+func (v *view) scroll(height int) {
+	if v.selected < v.top {
+		v.top = v.selected
+	}
+
+	for v.top < v.selected {
+		numLines := 0
+
+		for idx := v.top; idx <= v.selected; idx++ {
+			numLines += len(v.items[idx].lines)
+		}
+
+		if numLines <= height {
+			break
+		}
+
+		v.top++
+	}
+}
+
+func (v *view) selectedItem() (*item, bool) {
+	if len(v.items) == 0 {
+		return nil, false
+	}
+
+	return &v.items[v.selected], true
+}
+
+func (v *view) moveSelection(delta int) {
+	v.selected = max(0, min(len(v.items)-1, v.selected+delta))
+}
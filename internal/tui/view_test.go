@@ -0,0 +1,82 @@
+package tui_test
+
+import (
+	"slices"
+	"testing"
+
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/model"
+	"codeflow.dananglin.me.uk/apollo/enbas/internal/tui"
+)
+
+func TestStatusItems(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		statuses []model.Status
+		want     []string
+	}{
+		{
+			name:     "No filters",
+			statuses: []model.Status{newStatus("01"), newStatus("02")},
+			want:     []string{"01", "02"},
+		},
+		{
+			name:     "Hidden status is skipped",
+			statuses: []model.Status{newStatus("01"), newStatus("02", model.FilterActionHide), newStatus("03")},
+			want:     []string{"01", "03"},
+		},
+		{
+			name:     "Status with a warning is kept",
+			statuses: []model.Status{newStatus("01", model.FilterActionWarn)},
+			want:     []string{"01"},
+		},
+		{
+			name:     "Hide takes precedence over warn",
+			statuses: []model.Status{newStatus("01", model.FilterActionWarn, model.FilterActionHide)},
+			want:     []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tui.StatusItemIDs(tc.statuses); !slices.Equal(got, tc.want) {
+				t.Errorf("Unexpected statuses in the view: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNotificationItems(t *testing.T) {
+	t.Parallel()
+
+	hidden := newStatus("11", model.FilterActionHide)
+	warned := newStatus("12", model.FilterActionWarn)
+
+	notifications := []model.Notification{
+		{ID: "01", Type: "follow", Status: nil},
+		{ID: "02", Type: "mention", Status: &hidden},
+		{ID: "03", Type: "favourite", Status: &warned},
+	}
+
+	want := []string{"01", "03"}
+
+	if got := tui.NotificationItemIDs(notifications); !slices.Equal(got, want) {
+		t.Errorf("Unexpected notifications in the view: want %v, got %v", want, got)
+	}
+}
+
+// newStatus returns a status that matches a filter with each of the specified actions.
+func newStatus(id string, filterActions ...string) model.Status {
+	status := model.Status{ID: id}
+
+	for _, action := range filterActions {
+		status.Filtered = append(status.Filtered, model.FilterResult{
+			Filter: model.FilterV2{Action: action},
+		})
+	}
+
+	return status
+}
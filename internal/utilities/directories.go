@@ -10,8 +10,11 @@ import (
 )
 
 const (
-	cacheMediaDir    = "media"
-	cacheStatusesDir = "statuses"
+	cacheDraftsDir    = "drafts"
+	cacheImportsDir   = "imports"
+	cacheMediaDir     = "media"
+	cacheResponsesDir = "responses"
+	cacheStatusesDir  = "statuses"
 )
 
 func CalculateMediaCacheDir(cacheRoot, instance string) (string, error) {
@@ -32,11 +35,73 @@ func CalculateStatusesCacheDir(cacheRoot, instance string) (string, error) {
 	return filepath.Join(cacheDir, cacheStatusesDir), nil
 }
 
+// CalculateDraftsCacheDir returns the directory where the drafts of the
+// specified account are stored.
+func CalculateDraftsCacheDir(cacheRoot, instance, accountID string) (string, error) {
+	cacheDir, err := calculateCacheDir(cacheRoot, instance)
+	if err != nil {
+		return "", fmt.Errorf("unable to calculate the cache directory: %w", err)
+	}
+
+	return filepath.Join(cacheDir, cacheDraftsDir, accountID), nil
+}
+
+// CalculateImportsCacheDir returns the directory where the progress of the
+// imports to the specified account are stored.
+func CalculateImportsCacheDir(cacheRoot, instance, accountID string) (string, error) {
+	cacheDir, err := calculateCacheDir(cacheRoot, instance)
+	if err != nil {
+		return "", fmt.Errorf("unable to calculate the cache directory: %w", err)
+	}
+
+	return filepath.Join(cacheDir, cacheImportsDir, accountID), nil
+}
+
+// CalculateResponsesCacheDir returns the directory where the cached responses
+// from the instance are stored for the specified account.
+func CalculateResponsesCacheDir(cacheRoot, instance, accountKey string) (string, error) {
+	cacheDir, err := calculateCacheDir(cacheRoot, instance)
+	if err != nil {
+		return "", fmt.Errorf("unable to calculate the cache directory: %w", err)
+	}
+
+	return filepath.Join(cacheDir, cacheResponsesDir, accountKey), nil
+}
+
+// CalculateMediaCacheDirs returns the media cache directories of all the
+// instances that are present in the cache, mapped by the instance's domain.
+func CalculateMediaCacheDirs(cacheRoot string) (map[string]string, error) {
+	root, err := calculateCacheRoot(cacheRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := filepath.Glob(filepath.Join(root, "*", cacheMediaDir))
+	if err != nil {
+		return nil, fmt.Errorf("unable to search for the media cache directories: %w", err)
+	}
+
+	dirs := make(map[string]string)
+
+	for _, match := range matches {
+		dirs[filepath.Base(filepath.Dir(match))] = match
+	}
+
+	return dirs, nil
+}
+
 func calculateCacheDir(cacheRoot, instance string) (string, error) {
-	fqdn := GetFQDN(instance)
+	root, err := calculateCacheRoot(cacheRoot)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(root, GetFQDN(instance)), nil
+}
 
+func calculateCacheRoot(cacheRoot string) (string, error) {
 	if cacheRoot != "" {
-		return filepath.Join(cacheRoot, fqdn), nil
+		return cacheRoot, nil
 	}
 
 	cacheRoot, err := os.UserCacheDir()
@@ -44,7 +109,7 @@ func calculateCacheDir(cacheRoot, instance string) (string, error) {
 		return "", fmt.Errorf("unable to get your default cache directory: %w", err)
 	}
 
-	return filepath.Join(cacheRoot, info.ApplicationName, fqdn), nil
+	return filepath.Join(cacheRoot, info.ApplicationName), nil
 }
 
 // EnsureDirectory checks to see if the specified directory is present.
@@ -0,0 +1,58 @@
+package utilities
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+)
+
+// EditText opens the text in the user's text editor and returns the edited text.
+// The EDITOR environment variable is used if the editor is not specified in the
+// configuration file.
+func EditText(editor, text string) (string, error) {
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+
+	if editor == "" {
+		return "", UnspecifiedEditorError{}
+	}
+
+	file, err := os.CreateTemp("", "enbas-*.md")
+	if err != nil {
+		return "", fmt.Errorf("unable to create the temporary file: %w", err)
+	}
+
+	path := file.Name()
+	defer os.Remove(path)
+
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+
+		return "", fmt.Errorf("unable to write the text to the temporary file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("unable to close the temporary file: %w", err)
+	}
+
+	cmd := slices.Concat(strings.Split(editor, " "), []string{path})
+
+	command := exec.Command(cmd[0], cmd[1:]...) // #nosec G204 -- External command call defined in user's configuration file.
+	command.Stdin = os.Stdin
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+
+	if err := command.Run(); err != nil {
+		return "", fmt.Errorf("received an error after running the text editor: %w", err)
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the edited text: %w", err)
+	}
+
+	return string(edited), nil
+}
@@ -11,3 +11,9 @@ type UnspecifiedBrowserError struct{}
 func (e UnspecifiedBrowserError) Error() string {
 	return "the browser to view this link is not specified"
 }
+
+type UnspecifiedEditorError struct{}
+
+func (e UnspecifiedEditorError) Error() string {
+	return "the text editor is not specified in the configuration file or in the EDITOR environment variable"
+}